@@ -0,0 +1,96 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+	"github.com/gophercloud/gophercloud/openstack/utils"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	// blockStorageBackupV3UpdateMicroversion is the minimum microversion
+	// which supports updating a backup.
+	blockStorageBackupV3UpdateMicroversion = "3.9"
+
+	// blockStorageBackupV3MetadataMicroversion is the minimum microversion
+	// which supports the metadata of a backup.
+	blockStorageBackupV3MetadataMicroversion = "3.43"
+)
+
+// blockStorageBackupV3MetadataSupported queries the versions of the Block
+// Storage API and returns whether the v3 API supports the metadata of a
+// backup.
+// Gophercloud doesn't support querying the Block Storage API versions yet.
+func blockStorageBackupV3MetadataSupported(client *gophercloud.ServiceClient) (bool, error) {
+	endpoint, err := utils.BaseEndpoint(client.Endpoint)
+	if err != nil {
+		return false, err
+	}
+
+	var r struct {
+		Versions []struct {
+			ID      string `json:"id"`
+			Version string `json:"version"`
+		} `json:"versions"`
+	}
+
+	_, err = client.Get(endpoint, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 300},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range r.Versions {
+		if strings.HasPrefix(v.ID, "v3") {
+			return compatibleMicroversion("min", blockStorageBackupV3MetadataMicroversion, v.Version)
+		}
+	}
+
+	return false, nil
+}
+
+// blockStorageBackupV3UpdateOpts represents the attributes used when
+// updating an existing backup.
+// Gophercloud's backups.UpdateOpts doesn't nest the request in a backup
+// element and can't remove all of the metadata of a backup.
+type blockStorageBackupV3UpdateOpts struct {
+	Name        *string            `json:"name,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Metadata    *map[string]string `json:"metadata,omitempty"`
+}
+
+func blockStorageBackupV3Update(client *gophercloud.ServiceClient, id string, opts blockStorageBackupV3UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "backup")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("backups", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func blockStorageBackupV3StateRefreshFunc(client *gophercloud.ServiceClient, backupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		b, err := backups.Get(client, backupID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return b, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if b.Status == "error" || b.Status == "error_deleting" {
+			return b, b.Status, fmt.Errorf("The backup is in %s status: %s", b.Status, b.FailReason)
+		}
+
+		return b, b.Status, nil
+	}
+}
@@ -0,0 +1,79 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockStorageBackupV3MetadataSupported(t *testing.T) {
+	for _, maxVersion := range []string{"3.0", "3.43"} {
+		th.SetupHTTP()
+
+		th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusMultipleChoices)
+			fmt.Fprintf(w, `
+{
+  "versions": [
+    {
+      "id": "v2.0",
+      "status": "DEPRECATED",
+      "version": "",
+      "min_version": ""
+    },
+    {
+      "id": "v3.0",
+      "status": "CURRENT",
+      "version": "%s",
+      "min_version": "3.0"
+    }
+  ]
+}`, maxVersion)
+		})
+
+		client := thclient.ServiceClient()
+		client.Endpoint = th.Endpoint() + "v3/project/"
+
+		supported, err := blockStorageBackupV3MetadataSupported(client)
+		assert.NoError(t, err)
+		assert.Equal(t, maxVersion == "3.43", supported)
+
+		th.TeardownHTTP()
+	}
+}
+
+func TestBlockStorageBackupV3Update(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backups/backup", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `
+{
+  "backup": {
+    "name": "backup_1",
+    "metadata": {}
+  }
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"backup": {"id": "backup", "name": "backup_1"}}`)
+	})
+
+	name := "backup_1"
+	metadata := map[string]string{}
+	opts := blockStorageBackupV3UpdateOpts{
+		Name:     &name,
+		Metadata: &metadata,
+	}
+
+	assert.NoError(t, blockStorageBackupV3Update(thclient.ServiceClient(), "backup", opts))
+}
@@ -0,0 +1,185 @@
+package openstack
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// blockStorageQoSV3 represents a set of Cinder QoS specs.
+// Gophercloud doesn't support QoS specs yet.
+type blockStorageQoSV3 struct {
+	ID       string            `json:"id"`
+	Name     string            `json:"name"`
+	Consumer string            `json:"consumer"`
+	Specs    map[string]string `json:"specs"`
+}
+
+// blockStorageQoSV3Association represents an entity, usually a volume type,
+// associated with a set of QoS specs.
+type blockStorageQoSV3Association struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	AssociationType string `json:"association_type"`
+}
+
+// blockStorageQoSV3CreateOpts represents the attributes used when creating
+// a new set of QoS specs. The specs are sent next to the name and the
+// consumer.
+type blockStorageQoSV3CreateOpts struct {
+	Name     string
+	Consumer string
+	Specs    map[string]string
+}
+
+func (opts blockStorageQoSV3CreateOpts) toMap() map[string]interface{} {
+	qos := make(map[string]interface{}, len(opts.Specs)+2)
+	for k, v := range opts.Specs {
+		qos[k] = v
+	}
+
+	qos["name"] = opts.Name
+	if opts.Consumer != "" {
+		qos["consumer"] = opts.Consumer
+	}
+
+	return map[string]interface{}{"qos_specs": qos}
+}
+
+func blockStorageQoSV3Create(client *gophercloud.ServiceClient, opts blockStorageQoSV3CreateOpts) (*blockStorageQoSV3, error) {
+	var r struct {
+		QoS blockStorageQoSV3 `json:"qos_specs"`
+	}
+	_, err := client.Post(client.ServiceURL("qos-specs"), opts.toMap(), &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.QoS, nil
+}
+
+func blockStorageQoSV3Get(client *gophercloud.ServiceClient, id string) (*blockStorageQoSV3, error) {
+	var r struct {
+		QoS blockStorageQoSV3 `json:"qos_specs"`
+	}
+	_, err := client.Get(client.ServiceURL("qos-specs", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.QoS, nil
+}
+
+func blockStorageQoSV3List(client *gophercloud.ServiceClient) ([]blockStorageQoSV3, error) {
+	var r struct {
+		QoS []blockStorageQoSV3 `json:"qos_specs"`
+	}
+	_, err := client.Get(client.ServiceURL("qos-specs"), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.QoS, nil
+}
+
+// blockStorageQoSV3SetKeys sets the given keys of a set of QoS specs.
+// The consumer is set like any other key.
+func blockStorageQoSV3SetKeys(client *gophercloud.ServiceClient, id string, keys map[string]string) error {
+	b := map[string]interface{}{
+		"qos_specs": keys,
+	}
+
+	_, err := client.Put(client.ServiceURL("qos-specs", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func blockStorageQoSV3DeleteKeys(client *gophercloud.ServiceClient, id string, keys []string) error {
+	b := map[string]interface{}{
+		"keys": keys,
+	}
+
+	_, err := client.Put(client.ServiceURL("qos-specs", id, "delete_keys"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+func blockStorageQoSV3Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("qos-specs", id), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+func blockStorageQoSV3ListAssociations(client *gophercloud.ServiceClient, id string) ([]blockStorageQoSV3Association, error) {
+	var r struct {
+		Associations []blockStorageQoSV3Association `json:"qos_associations"`
+	}
+	_, err := client.Get(client.ServiceURL("qos-specs", id, "associations"), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Associations, nil
+}
+
+func blockStorageQoSV3Associate(client *gophercloud.ServiceClient, id, volumeTypeID string) error {
+	return blockStorageQoSV3AssociationAction(client, id, "associate", volumeTypeID)
+}
+
+func blockStorageQoSV3Disassociate(client *gophercloud.ServiceClient, id, volumeTypeID string) error {
+	return blockStorageQoSV3AssociationAction(client, id, "disassociate", volumeTypeID)
+}
+
+// Cinder (dis)associates QoS specs and volume types with GET requests.
+func blockStorageQoSV3AssociationAction(client *gophercloud.ServiceClient, id, action, volumeTypeID string) error {
+	query := url.Values{"vol_type_id": []string{volumeTypeID}}
+	u := client.ServiceURL("qos-specs", id, action) + "?" + query.Encode()
+
+	_, err := client.Get(u, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+// blockStorageQoSV3GetAssociation returns the association of a set of QoS
+// specs with a volume type, or a 404 error if they are not associated.
+func blockStorageQoSV3GetAssociation(client *gophercloud.ServiceClient, id, volumeTypeID string) (*blockStorageQoSV3Association, error) {
+	associations, err := blockStorageQoSV3ListAssociations(client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, association := range associations {
+		if association.AssociationType == "volume_type" && association.ID == volumeTypeID {
+			return &association, nil
+		}
+	}
+
+	return nil, gophercloud.ErrDefault404{}
+}
+
+// QoS associations have no ID in OpenStack.
+// Build an ID out of the QoS specs ID and the volume type ID.
+func blockStorageQoSAssociationV3ID(qosID, volumeTypeID string) string {
+	return fmt.Sprintf("%s/%s", qosID, volumeTypeID)
+}
+
+func blockStorageQoSAssociationV3ParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine QoS association ID from %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,160 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBlockStorageQoSV3Response = `
+{
+  "qos_specs": {
+    "id": "qos",
+    "name": "ssd",
+    "consumer": "back-end",
+    "specs": {
+      "read_iops_sec": "20000"
+    }
+  }
+}`
+
+var testBlockStorageQoSV3 = &blockStorageQoSV3{
+	ID:       "qos",
+	Name:     "ssd",
+	Consumer: "back-end",
+	Specs: map[string]string{
+		"read_iops_sec": "20000",
+	},
+}
+
+func TestBlockStorageQoSAssociationV3ParseID(t *testing.T) {
+	qosID, volumeTypeID, err := blockStorageQoSAssociationV3ParseID("qos/type")
+	assert.NoError(t, err)
+	assert.Equal(t, "qos", qosID)
+	assert.Equal(t, "type", volumeTypeID)
+
+	for _, id := range []string{"qos", "qos/", "/type", "qos/type/foo"} {
+		_, _, err := blockStorageQoSAssociationV3ParseID(id)
+		assert.Error(t, err)
+	}
+}
+
+func TestBlockStorageQoSV3Create(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/qos-specs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "qos_specs": {
+    "name": "ssd",
+    "consumer": "back-end",
+    "read_iops_sec": "20000"
+  }
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, testBlockStorageQoSV3Response)
+	})
+
+	actual, err := blockStorageQoSV3Create(thclient.ServiceClient(), blockStorageQoSV3CreateOpts{
+		Name:     "ssd",
+		Consumer: "back-end",
+		Specs: map[string]string{
+			"read_iops_sec": "20000",
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, testBlockStorageQoSV3, actual)
+}
+
+func TestBlockStorageQoSV3Get(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/qos-specs/qos", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, testBlockStorageQoSV3Response)
+	})
+
+	actual, err := blockStorageQoSV3Get(thclient.ServiceClient(), "qos")
+
+	assert.NoError(t, err)
+	assert.Equal(t, testBlockStorageQoSV3, actual)
+}
+
+func TestBlockStorageQoSV3DeleteKeys(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/qos-specs/qos/delete_keys", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"keys": ["read_iops_sec"]}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := blockStorageQoSV3DeleteKeys(thclient.ServiceClient(), "qos", []string{"read_iops_sec"})
+
+	assert.NoError(t, err)
+}
+
+func TestBlockStorageQoSV3Associate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/qos-specs/qos/associate", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		assert.Equal(t, "type", r.URL.Query().Get("vol_type_id"))
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := blockStorageQoSV3Associate(thclient.ServiceClient(), "qos", "type")
+
+	assert.NoError(t, err)
+}
+
+func TestBlockStorageQoSV3GetAssociation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/qos-specs/qos/associations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "qos_associations": [
+    {
+      "association_type": "volume_type",
+      "name": "ssd",
+      "id": "type"
+    }
+  ]
+}`)
+	})
+
+	association, err := blockStorageQoSV3GetAssociation(thclient.ServiceClient(), "qos", "type")
+	assert.NoError(t, err)
+	assert.Equal(t, &blockStorageQoSV3Association{
+		ID:              "type",
+		Name:            "ssd",
+		AssociationType: "volume_type",
+	}, association)
+
+	_, err = blockStorageQoSV3GetAssociation(thclient.ServiceClient(), "qos", "other")
+	assert.IsType(t, gophercloud.ErrDefault404{}, err)
+}
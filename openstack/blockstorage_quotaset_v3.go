@@ -0,0 +1,42 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func expandBlockStorageQuotasetV3UpdateOpts(d *schema.ResourceData) quotasets.UpdateOpts {
+	return quotasets.UpdateOpts{
+		Volumes:            expandQuotaValue(d, "volumes"),
+		Snapshots:          expandQuotaValue(d, "snapshots"),
+		Gigabytes:          expandQuotaValue(d, "gigabytes"),
+		PerVolumeGigabytes: expandQuotaValue(d, "per_volume_gigabytes"),
+		Backups:            expandQuotaValue(d, "backups"),
+		BackupGigabytes:    expandQuotaValue(d, "backup_gigabytes"),
+		Groups:             expandQuotaValue(d, "groups"),
+	}
+}
+
+func flattenBlockStorageQuotasetV3(q *quotasets.QuotaSet) map[string]int {
+	return map[string]int{
+		"volumes":              q.Volumes,
+		"snapshots":            q.Snapshots,
+		"gigabytes":            q.Gigabytes,
+		"per_volume_gigabytes": q.PerVolumeGigabytes,
+		"backups":              q.Backups,
+		"backup_gigabytes":     q.BackupGigabytes,
+		"groups":               q.Groups,
+	}
+}
+
+func flattenBlockStorageQuotasetV3Usage(q quotasets.QuotaUsageSet) map[string]quotasets.QuotaUsage {
+	return map[string]quotasets.QuotaUsage{
+		"volumes":              q.Volumes,
+		"snapshots":            q.Snapshots,
+		"gigabytes":            q.Gigabytes,
+		"per_volume_gigabytes": q.PerVolumeGigabytes,
+		"backups":              q.Backups,
+		"backup_gigabytes":     q.BackupGigabytes,
+		"groups":               q.Groups,
+	}
+}
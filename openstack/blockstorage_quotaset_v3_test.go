@@ -0,0 +1,22 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenBlockStorageQuotasetV3Usage(t *testing.T) {
+	usage := quotasets.QuotaUsageSet{
+		Volumes:   quotasets.QuotaUsage{InUse: 2, Allocated: 0, Reserved: 1, Limit: 10},
+		Gigabytes: quotasets.QuotaUsage{InUse: 20, Allocated: 0, Reserved: 0, Limit: 1000},
+	}
+
+	actual := flattenBlockStorageQuotasetV3Usage(usage)
+
+	assert.Len(t, actual, len(flattenBlockStorageQuotasetV3(&quotasets.QuotaSet{})))
+	assert.Equal(t, quotasets.QuotaUsage{InUse: 2, Reserved: 1, Limit: 10}, actual["volumes"])
+	assert.Equal(t, quotasets.QuotaUsage{InUse: 20, Limit: 1000}, actual["gigabytes"])
+	assert.Equal(t, quotasets.QuotaUsage{}, actual["backups"])
+}
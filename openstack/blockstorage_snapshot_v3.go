@@ -1,9 +1,12 @@
 package openstack
 
 import (
+	"fmt"
 	"sort"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+	"github.com/hashicorp/terraform/helper/resource"
 )
 
 // blockStorageV3SnapshotSort represents a sortable slice of block storage
@@ -29,3 +32,45 @@ func dataSourceBlockStorageV3MostRecentSnapshot(snapshots []snapshots.Snapshot)
 	sort.Sort(blockStorageV3SnapshotSort(sortedSnapshots))
 	return sortedSnapshots[len(sortedSnapshots)-1]
 }
+
+// blockStorageSnapshotV3UpdateOpts represents the attributes used when
+// updating an existing snapshot.
+// Gophercloud doesn't support updating v3 snapshots yet.
+type blockStorageSnapshotV3UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+func blockStorageSnapshotV3Update(client *gophercloud.ServiceClient, id string, opts blockStorageSnapshotV3UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "snapshot")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("snapshots", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func blockStorageSnapshotV3StateRefreshFunc(client *gophercloud.ServiceClient, snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		s, err := snapshots.Get(client, snapshotID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return s, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if s.Status == "error" || s.Status == "error_deleting" {
+			return s, s.Status, fmt.Errorf("The snapshot is in %s status. "+
+				"Please check with your cloud admin or check the Block Storage "+
+				"API logs to see why this error occurred.", s.Status)
+		}
+
+		return s, s.Status, nil
+	}
+}
@@ -0,0 +1,48 @@
+package openstack
+
+import (
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockStorageSnapshotV3Update(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/snapshots/snapshot", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `
+{
+  "snapshot": {
+    "description": ""
+  }
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	description := ""
+	updateOpts := blockStorageSnapshotV3UpdateOpts{
+		Description: &description,
+	}
+
+	err := blockStorageSnapshotV3Update(thclient.ServiceClient(), "snapshot", updateOpts)
+	assert.NoError(t, err)
+}
+
+func TestSnapshotV3UpdateMetadataOpts(t *testing.T) {
+	opts := SnapshotV3UpdateMetadataOpts{
+		Metadata: map[string]string{},
+	}
+
+	b, err := opts.ToSnapshotUpdateMetadataMap()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"metadata": map[string]interface{}{},
+	}, b)
+}
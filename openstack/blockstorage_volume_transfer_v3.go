@@ -0,0 +1,89 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// blockStorageVolumeTransferV3 represents a transfer of a volume to another
+// project. The authorization key is only returned when the transfer is
+// created.
+// Gophercloud doesn't support volume transfers yet.
+type blockStorageVolumeTransferV3 struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	VolumeID string `json:"volume_id"`
+	AuthKey  string `json:"auth_key"`
+}
+
+// blockStorageVolumeTransferV3CreateOpts represents the attributes used when
+// creating a new volume transfer.
+type blockStorageVolumeTransferV3CreateOpts struct {
+	VolumeID string `json:"volume_id" required:"true"`
+	Name     string `json:"name,omitempty"`
+}
+
+// blockStorageVolumeTransferV3AcceptOpts represents the attributes used when
+// accepting a volume transfer.
+type blockStorageVolumeTransferV3AcceptOpts struct {
+	AuthKey string `json:"auth_key" required:"true"`
+}
+
+func blockStorageVolumeTransferV3Create(client *gophercloud.ServiceClient, opts blockStorageVolumeTransferV3CreateOpts) (*blockStorageVolumeTransferV3, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "transfer")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Transfer blockStorageVolumeTransferV3 `json:"transfer"`
+	}
+	_, err = client.Post(client.ServiceURL("os-volume-transfer"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Transfer, nil
+}
+
+func blockStorageVolumeTransferV3Get(client *gophercloud.ServiceClient, id string) (*blockStorageVolumeTransferV3, error) {
+	var r struct {
+		Transfer blockStorageVolumeTransferV3 `json:"transfer"`
+	}
+	_, err := client.Get(client.ServiceURL("os-volume-transfer", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Transfer, nil
+}
+
+func blockStorageVolumeTransferV3Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("os-volume-transfer", id), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+// blockStorageVolumeTransferV3Accept accepts a volume transfer, moving the
+// volume to the project of the client.
+func blockStorageVolumeTransferV3Accept(client *gophercloud.ServiceClient, id string, opts blockStorageVolumeTransferV3AcceptOpts) (*blockStorageVolumeTransferV3, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "accept")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Transfer blockStorageVolumeTransferV3 `json:"transfer"`
+	}
+	_, err = client.Post(client.ServiceURL("os-volume-transfer", id, "accept"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Transfer, nil
+}
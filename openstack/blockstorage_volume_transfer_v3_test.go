@@ -0,0 +1,90 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockStorageVolumeTransferV3Create(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/os-volume-transfer", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "transfer": {
+    "volume_id": "volume",
+    "name": "transfer_1"
+  }
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `
+{
+  "transfer": {
+    "id": "transfer",
+    "name": "transfer_1",
+    "volume_id": "volume",
+    "auth_key": "9266c59563c84664",
+    "created_at": "2019-11-04T12:01:48.123456"
+  }
+}`)
+	})
+
+	actual, err := blockStorageVolumeTransferV3Create(thclient.ServiceClient(), blockStorageVolumeTransferV3CreateOpts{
+		VolumeID: "volume",
+		Name:     "transfer_1",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &blockStorageVolumeTransferV3{
+		ID:       "transfer",
+		Name:     "transfer_1",
+		VolumeID: "volume",
+		AuthKey:  "9266c59563c84664",
+	}, actual)
+}
+
+func TestBlockStorageVolumeTransferV3Accept(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/os-volume-transfer/transfer/accept", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "accept": {
+    "auth_key": "9266c59563c84664"
+  }
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `
+{
+  "transfer": {
+    "id": "transfer",
+    "name": "transfer_1",
+    "volume_id": "volume"
+  }
+}`)
+	})
+
+	actual, err := blockStorageVolumeTransferV3Accept(thclient.ServiceClient(), "transfer", blockStorageVolumeTransferV3AcceptOpts{
+		AuthKey: "9266c59563c84664",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &blockStorageVolumeTransferV3{
+		ID:       "transfer",
+		Name:     "transfer_1",
+		VolumeID: "volume",
+	}, actual)
+}
@@ -0,0 +1,103 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// blockStorageVolumeTypeV3Access represents the access of a project to a
+// private volume type.
+// Gophercloud doesn't support the extra specs and the access of volume types
+// yet.
+type blockStorageVolumeTypeV3Access struct {
+	VolumeTypeID string `json:"volume_type_id"`
+	ProjectID    string `json:"project_id"`
+}
+
+func blockStorageVolumeTypeV3SetExtraSpecs(client *gophercloud.ServiceClient, volumeTypeID string, extraSpecs map[string]string) error {
+	b := map[string]interface{}{
+		"extra_specs": extraSpecs,
+	}
+
+	_, err := client.Post(client.ServiceURL("types", volumeTypeID, "extra_specs"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func blockStorageVolumeTypeV3DeleteExtraSpec(client *gophercloud.ServiceClient, volumeTypeID, key string) error {
+	_, err := client.Delete(client.ServiceURL("types", volumeTypeID, "extra_specs", key), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+func blockStorageVolumeTypeV3ListAccesses(client *gophercloud.ServiceClient, volumeTypeID string) ([]blockStorageVolumeTypeV3Access, error) {
+	var r struct {
+		Accesses []blockStorageVolumeTypeV3Access `json:"volume_type_access"`
+	}
+	_, err := client.Get(client.ServiceURL("types", volumeTypeID, "os-volume-type-access"), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Accesses, nil
+}
+
+func blockStorageVolumeTypeV3AddAccess(client *gophercloud.ServiceClient, volumeTypeID, projectID string) error {
+	return blockStorageVolumeTypeV3Action(client, volumeTypeID, "addProjectAccess", projectID)
+}
+
+func blockStorageVolumeTypeV3RemoveAccess(client *gophercloud.ServiceClient, volumeTypeID, projectID string) error {
+	return blockStorageVolumeTypeV3Action(client, volumeTypeID, "removeProjectAccess", projectID)
+}
+
+func blockStorageVolumeTypeV3Action(client *gophercloud.ServiceClient, volumeTypeID, action, projectID string) error {
+	b := map[string]interface{}{
+		action: map[string]string{
+			"project": projectID,
+		},
+	}
+
+	_, err := client.Post(client.ServiceURL("types", volumeTypeID, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
+// blockStorageVolumeTypeV3GetAccess returns the access of a project to a
+// volume type, or a 404 error if the project has no access to it.
+func blockStorageVolumeTypeV3GetAccess(client *gophercloud.ServiceClient, volumeTypeID, projectID string) (*blockStorageVolumeTypeV3Access, error) {
+	accesses, err := blockStorageVolumeTypeV3ListAccesses(client, volumeTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, access := range accesses {
+		if access.ProjectID == projectID {
+			return &access, nil
+		}
+	}
+
+	return nil, gophercloud.ErrDefault404{}
+}
+
+// Volume type accesses have no ID in OpenStack.
+// Build an ID out of the volume type ID and the project ID.
+func blockStorageVolumeTypeAccessV3ID(volumeTypeID, projectID string) string {
+	return fmt.Sprintf("%s/%s", volumeTypeID, projectID)
+}
+
+func blockStorageVolumeTypeAccessV3ParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine volume type access ID from %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,115 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockStorageVolumeTypeAccessV3ParseID(t *testing.T) {
+	volumeTypeID, projectID, err := blockStorageVolumeTypeAccessV3ParseID("type/project")
+	assert.NoError(t, err)
+	assert.Equal(t, "type", volumeTypeID)
+	assert.Equal(t, "project", projectID)
+
+	for _, id := range []string{"type", "type/", "/project", "type/project/foo"} {
+		_, _, err := blockStorageVolumeTypeAccessV3ParseID(id)
+		assert.Error(t, err)
+	}
+}
+
+func TestBlockStorageVolumeTypeV3SetExtraSpecs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/types/type/extra_specs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "extra_specs": {
+    "volume_backend_name": "ssd"
+  }
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"extra_specs": {"volume_backend_name": "ssd"}}`)
+	})
+
+	err := blockStorageVolumeTypeV3SetExtraSpecs(thclient.ServiceClient(), "type", map[string]string{
+		"volume_backend_name": "ssd",
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestBlockStorageVolumeTypeV3DeleteExtraSpec(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/types/type/extra_specs/volume_backend_name", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := blockStorageVolumeTypeV3DeleteExtraSpec(thclient.ServiceClient(), "type", "volume_backend_name")
+
+	assert.NoError(t, err)
+}
+
+func TestBlockStorageVolumeTypeV3GetAccess(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/types/type/os-volume-type-access", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "volume_type_access": [
+    {
+      "volume_type_id": "type",
+      "project_id": "project"
+    }
+  ]
+}`)
+	})
+
+	access, err := blockStorageVolumeTypeV3GetAccess(thclient.ServiceClient(), "type", "project")
+	assert.NoError(t, err)
+	assert.Equal(t, &blockStorageVolumeTypeV3Access{
+		VolumeTypeID: "type",
+		ProjectID:    "project",
+	}, access)
+
+	_, err = blockStorageVolumeTypeV3GetAccess(thclient.ServiceClient(), "type", "other")
+	assert.IsType(t, gophercloud.ErrDefault404{}, err)
+}
+
+func TestBlockStorageVolumeTypeV3AddAccess(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/types/type/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "addProjectAccess": {
+    "project": "project"
+  }
+}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := blockStorageVolumeTypeV3AddAccess(thclient.ServiceClient(), "type", "project")
+
+	assert.NoError(t, err)
+}
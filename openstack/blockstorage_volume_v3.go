@@ -11,6 +11,27 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
+// blockStorageVolumeV3RetypeOpts represents the attributes used when
+// changing the type of a volume.
+// Gophercloud doesn't support the os-retype volume action yet.
+type blockStorageVolumeV3RetypeOpts struct {
+	NewType         string `json:"new_type" required:"true"`
+	MigrationPolicy string `json:"migration_policy,omitempty"`
+}
+
+func blockStorageVolumeV3Retype(client *gophercloud.ServiceClient, volumeID string, opts blockStorageVolumeV3RetypeOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "os-retype")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Post(client.ServiceURL("volumes", volumeID, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
+
 func flattenBlockStorageVolumeV3Attachments(v []volumes.Attachment) []map[string]interface{} {
 	attachments := make([]map[string]interface{}, len(v))
 	for i, attachment := range v {
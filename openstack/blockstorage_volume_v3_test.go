@@ -1,10 +1,13 @@
 package openstack
 
 import (
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -59,3 +62,30 @@ func TestBlockStorageVolumeV3AttachmentHash(t *testing.T) {
 
 	assert.Equal(t, expectedHashcode, actualHashcode)
 }
+
+func TestBlockStorageVolumeV3Retype(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/volumes/289da7f8-6440-407c-9fb4-7db01ec49164/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "os-retype": {
+    "new_type": "ssd",
+    "migration_policy": "on-demand"
+  }
+}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	retypeOpts := blockStorageVolumeV3RetypeOpts{
+		NewType:         "ssd",
+		MigrationPolicy: "on-demand",
+	}
+
+	err := blockStorageVolumeV3Retype(thclient.ServiceClient(), "289da7f8-6440-407c-9fb4-7db01ec49164", retypeOpts)
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,52 @@
+package openstack
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// computeAggregateV2ParseID converts the ID of an aggregate, which is an
+// integer in the compute API, from the resource ID.
+func computeAggregateV2ParseID(id string) (int, error) {
+	aggregateID, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid openstack_compute_aggregate_v2 ID %q: %s", id, err)
+	}
+
+	return aggregateID, nil
+}
+
+// expandComputeAggregateV2Metadata builds the metadata for a set_metadata
+// action. Keys which were removed from the configuration are set to nil,
+// which removes them from the aggregate.
+func expandComputeAggregateV2Metadata(oldMetadata, newMetadata map[string]interface{}) map[string]interface{} {
+	metadata := make(map[string]interface{})
+
+	for k := range oldMetadata {
+		if _, ok := newMetadata[k]; !ok {
+			metadata[k] = nil
+		}
+	}
+
+	for k, v := range newMetadata {
+		metadata[k] = v
+	}
+
+	return metadata
+}
+
+// flattenComputeAggregateV2Metadata removes the availability zone from the
+// metadata of an aggregate. Nova stores the availability zone as metadata,
+// but it's managed by the availability_zone attribute.
+func flattenComputeAggregateV2Metadata(metadata map[string]string) map[string]string {
+	m := make(map[string]string, len(metadata))
+
+	for k, v := range metadata {
+		if k == "availability_zone" {
+			continue
+		}
+		m[k] = v
+	}
+
+	return m
+}
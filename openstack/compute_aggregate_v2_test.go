@@ -0,0 +1,55 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeAggregateV2ParseID(t *testing.T) {
+	id, err := computeAggregateV2ParseID("42")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 42, id)
+
+	_, err = computeAggregateV2ParseID("foo")
+
+	assert.Error(t, err)
+}
+
+func TestExpandComputeAggregateV2Metadata(t *testing.T) {
+	oldMetadata := map[string]interface{}{
+		"foo": "bar",
+		"baz": "qux",
+	}
+
+	newMetadata := map[string]interface{}{
+		"foo":  "changed",
+		"quux": "corge",
+	}
+
+	expected := map[string]interface{}{
+		"foo":  "changed",
+		"baz":  nil,
+		"quux": "corge",
+	}
+
+	actual := expandComputeAggregateV2Metadata(oldMetadata, newMetadata)
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestFlattenComputeAggregateV2Metadata(t *testing.T) {
+	metadata := map[string]string{
+		"availability_zone": "az1",
+		"ssd":               "true",
+	}
+
+	expected := map[string]string{
+		"ssd": "true",
+	}
+
+	actual := flattenComputeAggregateV2Metadata(metadata)
+
+	assert.Equal(t, expected, actual)
+}
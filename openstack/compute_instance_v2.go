@@ -0,0 +1,141 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceComputeInstanceV2CustomizeDiff forces a new instance on an image
+// change, unless vendor_options.rebuild_on_image_change is set, in which
+// case the instance is rebuilt in place during Update.
+func resourceComputeInstanceV2CustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// Only check if the instance has been created.
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if !diff.HasChange("image_id") && !diff.HasChange("image_name") {
+		return nil
+	}
+
+	var rebuildOnImageChange bool
+	if vendorOptionsRaw, ok := diff.Get("vendor_options").(*schema.Set); ok && vendorOptionsRaw.Len() > 0 {
+		vendorOptions := expandVendorOptions(vendorOptionsRaw.List())
+		rebuildOnImageChange, _ = vendorOptions["rebuild_on_image_change"].(bool)
+	}
+
+	if !rebuildOnImageChange {
+		for _, key := range []string{"image_id", "image_name"} {
+			if diff.HasChange(key) {
+				if err := diff.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	// Only one of image_id and image_name is usually set by the user.
+	// The other one is refreshed from the rebuilt instance.
+	if !diff.HasChange("image_id") {
+		return diff.SetNewComputed("image_id")
+	}
+	if !diff.HasChange("image_name") {
+		return diff.SetNewComputed("image_name")
+	}
+
+	return nil
+}
+
+// computeInstanceV2ImportedSchedulerHintsDiffSuppressFunc suppresses the
+// removal of the scheduler hints discovered during an import. An import only
+// discovers the server group of an instance, so the diff is suppressed when
+// no hints are configured and the only hint in the state is a server group.
+func computeInstanceV2ImportedSchedulerHintsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+
+	// Without any configured hints, the new value falls back to the state.
+	// Since this function is only called for a diff, the same single hint in
+	// the old and the new value means that the hints have been removed.
+	o, n := d.GetChange("scheduler_hints")
+	oldHints, newHints := o.(*schema.Set), n.(*schema.Set)
+	if oldHints.Len() != 1 || newHints.Len() != 1 || oldHints.Difference(newHints).Len() != 0 {
+		return false
+	}
+
+	hint, ok := oldHints.List()[0].(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	for key, value := range hint {
+		switch v := value.(type) {
+		case string:
+			if key == "group" && v == "" || key != "group" && v != "" {
+				return false
+			}
+		case []interface{}:
+			if len(v) > 0 {
+				return false
+			}
+		case map[string]interface{}:
+			if len(v) > 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// computeInstanceV2RevertResize reverts a pending resize of an instance that
+// is still in the VERIFY_RESIZE state. The original resize error is always
+// returned, annotated with the result of the revert.
+func computeInstanceV2RevertResize(computeClient *gophercloud.ServiceClient, d *schema.ResourceData, resizeErr error) error {
+	server, err := servers.Get(computeClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("%s; unable to retrieve instance (%s) to revert resize: %s", resizeErr, d.Id(), err)
+	}
+
+	if server.Status != "VERIFY_RESIZE" {
+		return resizeErr
+	}
+
+	log.Printf("[DEBUG] Reverting resize of instance (%s)", d.Id())
+	err = servers.RevertResize(computeClient, d.Id()).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("%s; error reverting resize of OpenStack server: %s", resizeErr, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"REVERT_RESIZE", "VERIFY_RESIZE"},
+		Target:     []string{"ACTIVE", "SHUTOFF"},
+		Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("%s; error waiting for instance (%s) to revert resize: %s", resizeErr, d.Id(), err)
+	}
+
+	return fmt.Errorf("%s; resize of instance (%s) has been reverted", resizeErr, d.Id())
+}
+
+func validateComputeInstanceV2Duration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := time.ParseDuration(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid duration (e.g. \"30m\"): %s", k, err))
+	}
+	return
+}
@@ -445,7 +445,7 @@ func flattenInstanceNetworks(
 				if err != nil {
 					log.Printf("[WARN] Error getting default network uuid: %s", err)
 				} else {
-					if v["uuid"] != nil {
+					if networkInfo["uuid"] != nil {
 						v["uuid"] = networkInfo["uuid"].(string)
 					} else {
 						log.Printf("[WARN] Could not get default network uuid")
@@ -0,0 +1,212 @@
+package openstack
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateComputeInstanceV2Duration(t *testing.T) {
+	_, errs := validateComputeInstanceV2Duration("30m", "resize_timeout")
+	assert.Empty(t, errs)
+
+	_, errs = validateComputeInstanceV2Duration("1h30m", "resize_timeout")
+	assert.Empty(t, errs)
+
+	_, errs = validateComputeInstanceV2Duration("foo", "resize_timeout")
+	assert.Len(t, errs, 1)
+}
+
+func TestComputeInstanceV2RevertResizeNotVerifying(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/servers/instance", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "server": {
+    "id": "instance",
+    "name": "instance_1",
+    "status": "ERROR"
+  }
+}`)
+	})
+
+	th.Mux.HandleFunc("/servers/instance/action", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected action on an instance that is not in VERIFY_RESIZE")
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceComputeInstanceV2().Schema, map[string]interface{}{})
+	d.SetId("instance")
+
+	resizeErr := errors.New("resize failed")
+	err := computeInstanceV2RevertResize(thclient.ServiceClient(), d, resizeErr)
+
+	assert.Equal(t, resizeErr, err)
+}
+
+func testComputeInstanceV2CustomizeDiff(t *testing.T, raw map[string]interface{}) *terraform.InstanceDiff {
+	state := &terraform.InstanceState{
+		ID: "instance",
+		Attributes: map[string]string{
+			"name":           "instance_1",
+			"image_id":       "image",
+			"image_name":     "image_1",
+			"network.#":      "1",
+			"network.0.uuid": "network",
+		},
+	}
+
+	rawConfig, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	diff, err := resourceComputeInstanceV2().Diff(state, terraform.NewResourceConfig(rawConfig), nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return diff
+}
+
+func TestComputeInstanceV2CustomizeDiffReplace(t *testing.T) {
+	diff := testComputeInstanceV2CustomizeDiff(t, map[string]interface{}{
+		"name":     "instance_1",
+		"image_id": "new_image",
+	})
+
+	assert.True(t, diff.RequiresNew())
+	assert.Equal(t, "new_image", diff.Attributes["image_id"].New)
+	assert.True(t, diff.Attributes["image_id"].RequiresNew)
+}
+
+func TestComputeInstanceV2CustomizeDiffRebuild(t *testing.T) {
+	diff := testComputeInstanceV2CustomizeDiff(t, map[string]interface{}{
+		"name":     "instance_1",
+		"image_id": "new_image",
+		"vendor_options": []interface{}{
+			map[string]interface{}{
+				"rebuild_on_image_change": true,
+			},
+		},
+	})
+
+	assert.False(t, diff.RequiresNew())
+	assert.Equal(t, "new_image", diff.Attributes["image_id"].New)
+	assert.False(t, diff.Attributes["image_id"].RequiresNew)
+	assert.True(t, diff.Attributes["image_name"].NewComputed)
+}
+
+func TestComputeInstanceV2SchedulerHintsImported(t *testing.T) {
+	importedHint := map[string]interface{}{
+		"group":                 "group",
+		"different_host":        []interface{}{},
+		"same_host":             []interface{}{},
+		"query":                 []interface{}{},
+		"target_cell":           "",
+		"build_near_host_ip":    "",
+		"additional_properties": map[string]interface{}{},
+	}
+	configuredHint := map[string]interface{}{
+		"group":                 "group",
+		"different_host":        []interface{}{},
+		"same_host":             []interface{}{"instance"},
+		"query":                 []interface{}{},
+		"target_cell":           "",
+		"build_near_host_ip":    "",
+		"additional_properties": map[string]interface{}{},
+	}
+
+	testCases := []struct {
+		hint        map[string]interface{}
+		raw         map[string]interface{}
+		requiresNew bool
+	}{
+		{
+			hint: importedHint,
+			raw: map[string]interface{}{
+				"name": "instance_1",
+			},
+			requiresNew: false,
+		},
+		{
+			hint: importedHint,
+			raw: map[string]interface{}{
+				"name": "instance_1",
+				"scheduler_hints": []interface{}{
+					map[string]interface{}{
+						"group": "group",
+					},
+				},
+			},
+			requiresNew: false,
+		},
+		{
+			hint: importedHint,
+			raw: map[string]interface{}{
+				"name": "instance_1",
+				"scheduler_hints": []interface{}{
+					map[string]interface{}{
+						"group": "other_group",
+					},
+				},
+			},
+			requiresNew: true,
+		},
+		{
+			hint: configuredHint,
+			raw: map[string]interface{}{
+				"name": "instance_1",
+			},
+			requiresNew: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		hash := strconv.Itoa(resourceComputeSchedulerHintsHash(tc.hint))
+		attributes := map[string]string{
+			"name":                               "instance_1",
+			"image_id":                           "image",
+			"image_name":                         "image_1",
+			"network.#":                          "1",
+			"network.0.uuid":                     "network",
+			"scheduler_hints.#":                  "1",
+			"scheduler_hints." + hash + ".group": "group",
+		}
+		if sameHost := tc.hint["same_host"].([]interface{}); len(sameHost) > 0 {
+			attributes["scheduler_hints."+hash+".same_host.#"] = strconv.Itoa(len(sameHost))
+			attributes["scheduler_hints."+hash+".same_host.0"] = sameHost[0].(string)
+		}
+
+		state := &terraform.InstanceState{
+			ID:         "instance",
+			Attributes: attributes,
+		}
+
+		rawConfig, err := config.NewRawConfig(tc.raw)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		diff, err := resourceComputeInstanceV2().Diff(state, terraform.NewResourceConfig(rawConfig), nil)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		assert.Equal(t, tc.requiresNew, diff.RequiresNew())
+	}
+}
@@ -0,0 +1,63 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/quotasets"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func expandComputeQuotasetV2UpdateOpts(d *schema.ResourceData) quotasets.UpdateOpts {
+	return quotasets.UpdateOpts{
+		FixedIPs:                 expandQuotaValue(d, "fixed_ips"),
+		FloatingIPs:              expandQuotaValue(d, "floating_ips"),
+		InjectedFileContentBytes: expandQuotaValue(d, "injected_file_content_bytes"),
+		InjectedFilePathBytes:    expandQuotaValue(d, "injected_file_path_bytes"),
+		InjectedFiles:            expandQuotaValue(d, "injected_files"),
+		KeyPairs:                 expandQuotaValue(d, "key_pairs"),
+		MetadataItems:            expandQuotaValue(d, "metadata_items"),
+		RAM:                      expandQuotaValue(d, "ram"),
+		SecurityGroupRules:       expandQuotaValue(d, "security_group_rules"),
+		SecurityGroups:           expandQuotaValue(d, "security_groups"),
+		Cores:                    expandQuotaValue(d, "cores"),
+		Instances:                expandQuotaValue(d, "instances"),
+		ServerGroups:             expandQuotaValue(d, "server_groups"),
+		ServerGroupMembers:       expandQuotaValue(d, "server_group_members"),
+	}
+}
+
+func flattenComputeQuotasetV2(q *quotasets.QuotaSet) map[string]int {
+	return map[string]int{
+		"fixed_ips":                   q.FixedIPs,
+		"floating_ips":                q.FloatingIPs,
+		"injected_file_content_bytes": q.InjectedFileContentBytes,
+		"injected_file_path_bytes":    q.InjectedFilePathBytes,
+		"injected_files":              q.InjectedFiles,
+		"key_pairs":                   q.KeyPairs,
+		"metadata_items":              q.MetadataItems,
+		"ram":                         q.RAM,
+		"security_group_rules":        q.SecurityGroupRules,
+		"security_groups":             q.SecurityGroups,
+		"cores":                       q.Cores,
+		"instances":                   q.Instances,
+		"server_groups":               q.ServerGroups,
+		"server_group_members":        q.ServerGroupMembers,
+	}
+}
+
+func flattenComputeQuotasetV2Details(q quotasets.QuotaDetailSet) map[string]quotasets.QuotaDetail {
+	return map[string]quotasets.QuotaDetail{
+		"fixed_ips":                   q.FixedIPs,
+		"floating_ips":                q.FloatingIPs,
+		"injected_file_content_bytes": q.InjectedFileContentBytes,
+		"injected_file_path_bytes":    q.InjectedFilePathBytes,
+		"injected_files":              q.InjectedFiles,
+		"key_pairs":                   q.KeyPairs,
+		"metadata_items":              q.MetadataItems,
+		"ram":                         q.RAM,
+		"security_group_rules":        q.SecurityGroupRules,
+		"security_groups":             q.SecurityGroups,
+		"cores":                       q.Cores,
+		"instances":                   q.Instances,
+		"server_groups":               q.ServerGroups,
+		"server_group_members":        q.ServerGroupMembers,
+	}
+}
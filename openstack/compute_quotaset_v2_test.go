@@ -0,0 +1,22 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/quotasets"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenComputeQuotasetV2Details(t *testing.T) {
+	details := quotasets.QuotaDetailSet{
+		Cores:     quotasets.QuotaDetail{InUse: 4, Reserved: 1, Limit: 20},
+		Instances: quotasets.QuotaDetail{InUse: 2, Reserved: 0, Limit: 10},
+	}
+
+	actual := flattenComputeQuotasetV2Details(details)
+
+	assert.Len(t, actual, len(flattenComputeQuotasetV2(&quotasets.QuotaSet{})))
+	assert.Equal(t, quotasets.QuotaDetail{InUse: 4, Reserved: 1, Limit: 20}, actual["cores"])
+	assert.Equal(t, quotasets.QuotaDetail{InUse: 2, Reserved: 0, Limit: 10}, actual["instances"])
+	assert.Equal(t, quotasets.QuotaDetail{}, actual["ram"])
+}
@@ -416,6 +416,38 @@ func (c *Config) objectStorageV1Client(region string) (*gophercloud.ServiceClien
 	return client, nil
 }
 
+func (c *Config) keyManagerV1Client(region string) (*gophercloud.ServiceClient, error) {
+	client, err := openstack.NewKeyManagerV1(c.OsClient, gophercloud.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getEndpointType(),
+	})
+
+	if err != nil {
+		return client, err
+	}
+
+	// Check if an endpoint override was specified for the key-manager service.
+	client = c.determineEndpoint(client, "key-manager")
+
+	return client, nil
+}
+
+func (c *Config) orchestrationV1Client(region string) (*gophercloud.ServiceClient, error) {
+	client, err := openstack.NewOrchestrationV1(c.OsClient, gophercloud.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getEndpointType(),
+	})
+
+	if err != nil {
+		return client, err
+	}
+
+	// Check if an endpoint override was specified for the orchestration service.
+	client = c.determineEndpoint(client, "orchestration")
+
+	return client, nil
+}
+
 func (c *Config) loadBalancerV2Client(region string) (*gophercloud.ServiceClient, error) {
 	client, err := openstack.NewLoadBalancerV2(c.OsClient, gophercloud.EndpointOpts{
 		Region:       c.determineRegion(region),
@@ -0,0 +1,147 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceBlockStorageBackupV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBlockStorageBackupV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"container": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"incremental": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"has_dependent_backups": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBlockStorageBackupV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	listOpts := backups.ListOpts{
+		Name:     d.Get("name").(string),
+		Status:   d.Get("status").(string),
+		VolumeID: d.Get("volume_id").(string),
+	}
+
+	// Backups are only listed with their ID and name,
+	// so let the API sort them by creation date.
+	recent := d.Get("most_recent").(bool)
+	if recent {
+		listOpts.Sort = "created_at:desc"
+	}
+
+	allPages, err := backups.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query openstack_blockstorage_backups_v3: %s", err)
+	}
+
+	allBackups, err := backups.ExtractBackups(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_blockstorage_backups_v3: %s", err)
+	}
+
+	if len(allBackups) < 1 {
+		return fmt.Errorf("Your openstack_blockstorage_backup_v3 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allBackups) > 1 && !recent {
+		log.Printf("[DEBUG] Multiple openstack_blockstorage_backup_v3 results found: %#v", allBackups)
+
+		return fmt.Errorf("Your query returned more than one result. Please try a more " +
+			"specific search criteria, or set `most_recent` attribute to true.")
+	}
+
+	backup, err := backups.Get(client, allBackups[0].ID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_blockstorage_backup_v3 %s: %s", allBackups[0].ID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_backup_v3 %s: %#v", backup.ID, backup)
+
+	d.SetId(backup.ID)
+	d.Set("name", backup.Name)
+	d.Set("description", backup.Description)
+	d.Set("status", backup.Status)
+	d.Set("volume_id", backup.VolumeID)
+	d.Set("snapshot_id", backup.SnapshotID)
+	d.Set("container", backup.Container)
+	d.Set("incremental", backup.IsIncremental)
+	d.Set("size", backup.Size)
+	d.Set("object_count", backup.ObjectCount)
+	d.Set("has_dependent_backups", backup.HasDependentBackups)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
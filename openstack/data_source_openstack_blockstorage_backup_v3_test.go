@@ -0,0 +1,43 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBlockStorageV3BackupDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_blockstorage_backup_v3.backup_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckBackup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3BackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3Backup_basic,
+			},
+			{
+				Config: testAccBlockStorageV3BackupDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_blockstorage_backup_v3.backup_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "backup_1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttrPair(resourceName, "volume_id",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+var testAccBlockStorageV3BackupDataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_backup_v3" "backup_1" {
+  volume_id = "${openstack_blockstorage_backup_v3.backup_1.volume_id}"
+  most_recent = true
+}
+`, testAccBlockStorageV3Backup_basic)
@@ -0,0 +1,106 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceBlockStorageQoSV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBlockStorageQoSV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed values
+			"consumer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"specs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"volume_type_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceBlockStorageQoSV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	allQoS, err := blockStorageQoSV3List(client)
+	if err != nil {
+		return fmt.Errorf("Unable to query openstack_blockstorage_qos_v3: %s", err)
+	}
+
+	// The QoS specs cannot be filtered by name in the API.
+	name := d.Get("name").(string)
+	var found []blockStorageQoSV3
+	for _, qos := range allQoS {
+		if qos.Name == name {
+			found = append(found, qos)
+		}
+	}
+
+	if len(found) < 1 {
+		return fmt.Errorf("Your openstack_blockstorage_qos_v3 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(found) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_blockstorage_qos_v3 results found: %#v", found)
+
+		return fmt.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	qos := found[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_qos_v3 %s: %#v", qos.ID, qos)
+
+	associations, err := blockStorageQoSV3ListAssociations(client, qos.ID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving associations of openstack_blockstorage_qos_v3 %s: %s", qos.ID, err)
+	}
+
+	var volumeTypeIDs []string
+	for _, association := range associations {
+		if association.AssociationType == "volume_type" {
+			volumeTypeIDs = append(volumeTypeIDs, association.ID)
+		}
+	}
+
+	d.SetId(qos.ID)
+	d.Set("name", qos.Name)
+	d.Set("consumer", qos.Consumer)
+	d.Set("volume_type_ids", volumeTypeIDs)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("specs", qos.Specs); err != nil {
+		log.Printf("[DEBUG] Unable to set specs for openstack_blockstorage_qos_v3 %s: %s", qos.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBlockStorageV3QoSDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_blockstorage_qos_v3.qos_1"
+	qosName := acctest.RandomWithPrefix("tf-acc-qos")
+	typeName := acctest.RandomWithPrefix("tf-acc-type")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3QoSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3QoSAssociation_basic(qosName, typeName),
+			},
+			{
+				Config: testAccBlockStorageV3QoSDataSource_basic(qosName, typeName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_blockstorage_qos_v3.qos_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", qosName),
+					resource.TestCheckResourceAttr(resourceName, "consumer", "back-end"),
+					resource.TestCheckResourceAttr(resourceName, "specs.read_iops_sec", "20000"),
+					resource.TestCheckResourceAttr(resourceName, "volume_type_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "volume_type_ids.0",
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlockStorageV3QoSDataSource_basic(qosName, typeName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_qos_v3" "qos_1" {
+  name = "${openstack_blockstorage_qos_v3.qos_1.name}"
+}
+`, testAccBlockStorageV3QoSAssociation_basic(qosName, typeName))
+}
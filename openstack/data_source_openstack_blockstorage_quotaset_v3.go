@@ -0,0 +1,117 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceBlockStorageQuotasetV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBlockStorageQuotasetV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"volumes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"snapshots": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"gigabytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"per_volume_gigabytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"backups": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"backup_gigabytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"groups": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"in_use": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"allocated": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"reserved": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceBlockStorageQuotasetV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+
+	q, err := quotasets.GetUsage(blockStorageClient, projectID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_blockstorage_quotaset_v3 %s: %s", projectID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_quotaset_v3 %s: %#v", projectID, q)
+
+	d.SetId(projectID)
+
+	inUse := make(map[string]int)
+	allocated := make(map[string]int)
+	reserved := make(map[string]int)
+	for k, v := range flattenBlockStorageQuotasetV3Usage(q) {
+		d.Set(k, v.Limit)
+		inUse[k] = v.InUse
+		allocated[k] = v.Allocated
+		reserved[k] = v.Reserved
+	}
+
+	d.Set("in_use", inUse)
+	d.Set("allocated", allocated)
+	d.Set("reserved", reserved)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
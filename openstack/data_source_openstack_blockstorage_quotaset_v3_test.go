@@ -0,0 +1,40 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBlockStorageV3QuotasetDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3QuotasetDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_blockstorage_quotaset_v3.quotaset_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_quotaset_v3.quotaset_1", "volumes", "10"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_quotaset_v3.quotaset_1", "in_use.volumes", "0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccBlockStorageV3QuotasetDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_quotaset_v3" "quotaset_1" {
+  project_id = "${openstack_blockstorage_quotaset_v3.quotaset_1.project_id}"
+}
+`, testAccBlockStorageV3QuotasetBasic)
@@ -0,0 +1,105 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceBlockStorageVolumeTypeV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBlockStorageVolumeTypeV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed values
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"is_public": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"extra_specs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"qos_specs_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBlockStorageVolumeTypeV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	allPages, err := volumetypes.List(client, volumetypes.ListOpts{}).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query openstack_blockstorage_volume_types_v3: %s", err)
+	}
+
+	allVolumeTypes, err := volumetypes.ExtractVolumeTypes(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_blockstorage_volume_types_v3: %s", err)
+	}
+
+	// The volume types cannot be filtered by name in the API.
+	name := d.Get("name").(string)
+	var found []volumetypes.VolumeType
+	for _, vt := range allVolumeTypes {
+		if vt.Name == name {
+			found = append(found, vt)
+		}
+	}
+
+	if len(found) < 1 {
+		return fmt.Errorf("Your openstack_blockstorage_volume_type_v3 query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(found) > 1 {
+		log.Printf("[DEBUG] Multiple openstack_blockstorage_volume_type_v3 results found: %#v", found)
+
+		return fmt.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	vt := found[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_volume_type_v3 %s: %#v", vt.ID, vt)
+
+	d.SetId(vt.ID)
+	d.Set("name", vt.Name)
+	d.Set("description", vt.Description)
+	d.Set("is_public", vt.IsPublic)
+	d.Set("qos_specs_id", vt.QosSpecID)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("extra_specs", vt.ExtraSpecs); err != nil {
+		log.Printf("[DEBUG] Unable to set extra_specs for openstack_blockstorage_volume_type_v3 %s: %s", vt.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBlockStorageV3VolumeTypeDataSource_basic(t *testing.T) {
+	resourceName := "data.openstack_blockstorage_volume_type_v3.volume_type_1"
+	typeName := acctest.RandomWithPrefix("tf-acc-type")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeType_basic(typeName),
+			},
+			{
+				Config: testAccBlockStorageV3VolumeTypeDataSource_basic(typeName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", typeName),
+					resource.TestCheckResourceAttr(resourceName, "is_public", "true"),
+					resource.TestCheckResourceAttr(resourceName, "extra_specs.capabilities", "gpu"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlockStorageV3VolumeTypeDataSource_basic(typeName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "${openstack_blockstorage_volume_type_v3.volume_type_1.name}"
+}
+`, testAccBlockStorageV3VolumeType_basic(typeName))
+}
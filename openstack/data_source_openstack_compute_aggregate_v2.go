@@ -0,0 +1,96 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeAggregateV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeAggregateV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"hosts": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceComputeAggregateV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	pages, err := aggregates.List(computeClient).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query openstack_compute_aggregate_v2: %s", err)
+	}
+
+	allAggregates, err := aggregates.ExtractAggregates(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_compute_aggregate_v2: %s", err)
+	}
+
+	// The API doesn't support filtering, so the aggregates are filtered here.
+	name := d.Get("name").(string)
+	var matchingAggregates []aggregates.Aggregate
+	for _, aggregate := range allAggregates {
+		if aggregate.Name == name {
+			matchingAggregates = append(matchingAggregates, aggregate)
+		}
+	}
+
+	if len(matchingAggregates) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(matchingAggregates) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	aggregate := matchingAggregates[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_aggregate_v2 %d: %#v", aggregate.ID, aggregate)
+	d.SetId(strconv.Itoa(aggregate.ID))
+
+	d.Set("name", aggregate.Name)
+	d.Set("availability_zone", aggregate.AvailabilityZone)
+	d.Set("metadata", flattenComputeAggregateV2Metadata(aggregate.Metadata))
+	d.Set("hosts", aggregate.Hosts)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
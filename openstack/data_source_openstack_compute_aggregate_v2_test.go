@@ -0,0 +1,45 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeV2AggregateDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2Aggregate_basic,
+			},
+			{
+				Config: testAccComputeV2AggregateDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_aggregate_v2.aggregate_1", "id",
+						"openstack_compute_aggregate_v2.aggregate_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_aggregate_v2.aggregate_1", "name", "aggregate_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_aggregate_v2.aggregate_1", "availability_zone", "az_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_aggregate_v2.aggregate_1", "metadata.ssd", "true"),
+				),
+			},
+		},
+	})
+}
+
+var testAccComputeV2AggregateDataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_compute_aggregate_v2" "aggregate_1" {
+  name = "${openstack_compute_aggregate_v2.aggregate_1.name}"
+}
+`, testAccComputeV2Aggregate_basic)
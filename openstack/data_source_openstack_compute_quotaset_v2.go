@@ -0,0 +1,143 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/quotasets"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeQuotasetV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeQuotasetV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"fixed_ips": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"floating_ips": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"injected_file_content_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"injected_file_path_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"injected_files": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"key_pairs": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"metadata_items": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"ram": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"security_group_rules": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"security_groups": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"cores": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"instances": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"server_groups": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"server_group_members": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"in_use": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"reserved": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceComputeQuotasetV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+
+	q, err := quotasets.GetDetail(computeClient, projectID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_compute_quotaset_v2 %s: %s", projectID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_quotaset_v2 %s: %#v", projectID, q)
+
+	d.SetId(projectID)
+
+	inUse := make(map[string]int)
+	reserved := make(map[string]int)
+	for k, v := range flattenComputeQuotasetV2Details(q) {
+		d.Set(k, v.Limit)
+		inUse[k] = v.InUse
+		reserved[k] = v.Reserved
+	}
+
+	d.Set("in_use", inUse)
+	d.Set("reserved", reserved)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
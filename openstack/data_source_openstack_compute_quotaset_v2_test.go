@@ -0,0 +1,40 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeV2QuotasetDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2QuotasetDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_quotaset_v2.quotaset_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_quotaset_v2.quotaset_1", "key_pairs", "10"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_quotaset_v2.quotaset_1", "in_use.key_pairs", "0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccComputeV2QuotasetDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "openstack_compute_quotaset_v2" "quotaset_1" {
+  project_id = "${openstack_compute_quotaset_v2.quotaset_1.project_id}"
+}
+`, testAccComputeV2QuotasetBasic)
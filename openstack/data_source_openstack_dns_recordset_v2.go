@@ -0,0 +1,165 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDNSRecordSetV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDNSRecordSetV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDNSRecordSetV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	listOpts := recordsets.ListOpts{}
+
+	if v, ok := d.GetOk("name"); ok {
+		listOpts.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		listOpts.Type = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		listOpts.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		listOpts.Status = v.(string)
+	}
+
+	if v, ok := d.GetOk("ttl"); ok {
+		listOpts.TTL = v.(int)
+	}
+
+	pages, err := recordsets.ListByZone(dnsClient, zoneID, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve recordsets of zone %s: %s", zoneID, err)
+	}
+
+	allRecordSets, err := recordsets.ExtractRecordSets(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract recordsets of zone %s: %s", zoneID, err)
+	}
+
+	if len(allRecordSets) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allRecordSets) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	recordSet := allRecordSets[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_recordset_v2 %s: %+v", recordSet.ID, recordSet)
+	d.SetId(fmt.Sprintf("%s/%s", recordSet.ZoneID, recordSet.ID))
+
+	d.Set("zone_id", recordSet.ZoneID)
+	d.Set("zone_name", recordSet.ZoneName)
+	d.Set("project_id", recordSet.ProjectID)
+	d.Set("name", recordSet.Name)
+	d.Set("type", recordSet.Type)
+	d.Set("description", recordSet.Description)
+	d.Set("status", recordSet.Status)
+	d.Set("ttl", recordSet.TTL)
+	d.Set("version", recordSet.Version)
+	d.Set("created_at", recordSet.CreatedAt.Format(time.RFC3339))
+	d.Set("updated_at", recordSet.UpdatedAt.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("records", recordSet.Records); err != nil {
+		log.Printf("[DEBUG] Unable to set records for openstack_dns_recordset_v2 %s: %s", recordSet.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackDNSRecordSetV2DataSource_basic(t *testing.T) {
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDNS(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2RecordSet_basic(zoneName),
+			},
+			{
+				Config: testAccOpenStackDNSRecordSetV2DataSource_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSRecordSetV2DataSourceID("data.openstack_dns_recordset_v2.rs1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_dns_recordset_v2.rs1", "id",
+						"openstack_dns_recordset_v2.recordset_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordset_v2.rs1", "name", zoneName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordset_v2.rs1", "type", "A"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordset_v2.rs1", "ttl", "3000"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_dns_recordset_v2.rs1", "records.0", "10.1.0.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSRecordSetV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find DNS RecordSet data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("DNS RecordSet data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccOpenStackDNSRecordSetV2DataSource_basic(zoneName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_dns_recordset_v2" "rs1" {
+	zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+	name = "${openstack_dns_recordset_v2.recordset_1.name}"
+	type = "A"
+}
+`, testAccDNSV2RecordSet_basic(zoneName))
+}
@@ -124,6 +124,16 @@ func dataSourceImagesImageV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"os_hash_algo": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"os_hash_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"size_bytes": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -273,6 +283,8 @@ func dataSourceImagesImageV2Attributes(d *schema.ResourceData, image *images.Ima
 	d.Set("protected", image.Protected)
 	d.Set("visibility", image.Visibility)
 	d.Set("checksum", image.Checksum)
+	d.Set("os_hash_algo", image.Properties[imagesImageV2HashAlgoProperty])
+	d.Set("os_hash_value", image.Properties[imagesImageV2HashValueProperty])
 	d.Set("size_bytes", image.SizeBytes)
 	d.Set("metadata", image.Metadata)
 	d.Set("created_at", image.CreatedAt.Format(time.RFC3339))
@@ -0,0 +1,167 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/acls"
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/containers"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceKeyManagerContainerV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeyManagerContainerV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secret_refs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"secret_ref": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"acl": keyManagerV1ACLDataSourceSchema(),
+
+			"container_ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creator_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"consumers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeyManagerContainerV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	listOpts := containers.ListOpts{
+		Name: d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_keymanager_container_v1 list options: %#v", listOpts)
+
+	pages, err := containers.List(kmClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query openstack_keymanager_container_v1: %s", err)
+	}
+
+	allContainers, err := containers.ExtractContainers(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_keymanager_container_v1: %s", err)
+	}
+
+	if len(allContainers) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allContainers) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	container := allContainers[0]
+
+	id, err := keyManagerV1ParseRef(container.ContainerRef)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_keymanager_container_v1 %s: %#v", id, container)
+	d.SetId(id)
+
+	d.Set("name", container.Name)
+	d.Set("type", container.Type)
+	d.Set("container_ref", container.ContainerRef)
+	d.Set("creator_id", container.CreatorID)
+	d.Set("status", container.Status)
+	d.Set("created_at", container.Created.Format(time.RFC3339))
+	d.Set("updated_at", container.Updated.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("secret_refs", flattenKeyManagerContainerV1SecretRefs(container.SecretRefs)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_keymanager_container_v1 %s secret_refs: %s", id, err)
+	}
+
+	if err := d.Set("consumers", flattenKeyManagerContainerV1Consumers(container.Consumers)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_keymanager_container_v1 %s consumers: %s", id, err)
+	}
+
+	acl, err := acls.GetContainerACL(kmClient, id).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving ACL of openstack_keymanager_container_v1 %s: %s", id, err)
+	}
+	d.Set("acl", flattenKeyManagerV1ACLs(acl))
+
+	return nil
+}
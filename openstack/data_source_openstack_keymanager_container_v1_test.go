@@ -0,0 +1,61 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccKeyManagerContainerV1DataSource_basic(t *testing.T) {
+	containerName := fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckKeyManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyManagerContainerV1_basic(containerName),
+			},
+			{
+				Config: testAccKeyManagerContainerV1DataSource_basic(containerName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerContainerV1DataSourceID("data.openstack_keymanager_container_v1.container_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_container_v1.container_1", "name", containerName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_container_v1.container_1", "type", "generic"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_container_v1.container_1", "secret_refs.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyManagerContainerV1DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find container data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Container data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccKeyManagerContainerV1DataSource_basic(containerName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_keymanager_container_v1" "container_1" {
+  name = "${openstack_keymanager_container_v1.container_1.name}"
+}
+`, testAccKeyManagerContainerV1_basic(containerName))
+}
@@ -0,0 +1,207 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/acls"
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/secrets"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceKeyManagerSecretV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeyManagerSecretV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"bit_length": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"secret_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(keyManagerSecretV1SecretTypes(), false),
+			},
+
+			"acl_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"secret_ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creator_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_types": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"payload": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"payload_content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"acl": keyManagerV1ACLDataSourceSchema(),
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeyManagerSecretV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	listOpts := secrets.ListOpts{
+		Name:       d.Get("name").(string),
+		Bits:       d.Get("bit_length").(int),
+		Alg:        d.Get("algorithm").(string),
+		Mode:       d.Get("mode").(string),
+		SecretType: secrets.SecretType(d.Get("secret_type").(string)),
+	}
+
+	if v, ok := d.GetOkExists("acl_only"); ok {
+		aclOnly := v.(bool)
+		listOpts.ACLOnly = &aclOnly
+	}
+
+	log.Printf("[DEBUG] openstack_keymanager_secret_v1 list options: %#v", listOpts)
+
+	pages, err := secrets.List(kmClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query openstack_keymanager_secret_v1: %s", err)
+	}
+
+	allSecrets, err := secrets.ExtractSecrets(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_keymanager_secret_v1: %s", err)
+	}
+
+	if len(allSecrets) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allSecrets) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	secret := allSecrets[0]
+
+	id, err := keyManagerV1ParseRef(secret.SecretRef)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_keymanager_secret_v1 %s: %#v", id, secret)
+	d.SetId(id)
+
+	d.Set("name", secret.Name)
+	d.Set("bit_length", secret.BitLength)
+	d.Set("algorithm", secret.Algorithm)
+	d.Set("mode", secret.Mode)
+	d.Set("secret_type", secret.SecretType)
+	d.Set("secret_ref", secret.SecretRef)
+	d.Set("creator_id", secret.CreatorID)
+	d.Set("status", secret.Status)
+	d.Set("content_types", secret.ContentTypes)
+	d.Set("created_at", secret.Created.Format(time.RFC3339))
+	d.Set("updated_at", secret.Updated.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	if !secret.Expiration.IsZero() {
+		d.Set("expiration", secret.Expiration.Format(time.RFC3339))
+	}
+
+	if contentType, ok := secret.ContentTypes["default"]; ok {
+		payload, err := keyManagerSecretV1GetPayload(kmClient, id, contentType, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving payload of openstack_keymanager_secret_v1 %s: %s", id, err)
+		}
+		d.Set("payload", payload)
+		d.Set("payload_content_type", contentType)
+	}
+
+	metadata, err := secrets.GetMetadata(kmClient, id).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving metadata of openstack_keymanager_secret_v1 %s: %s", id, err)
+	}
+	d.Set("metadata", metadata)
+
+	acl, err := acls.GetSecretACL(kmClient, id).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving ACL of openstack_keymanager_secret_v1 %s: %s", id, err)
+	}
+	d.Set("acl", flattenKeyManagerV1ACLs(acl))
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccKeyManagerSecretV1DataSource_basic(t *testing.T) {
+	secretName := fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckKeyManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyManagerSecretV1_basic(secretName),
+			},
+			{
+				Config: testAccKeyManagerSecretV1DataSource_basic(secretName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerSecretV1DataSourceID("data.openstack_keymanager_secret_v1.secret_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_secret_v1.secret_1", "name", secretName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_secret_v1.secret_1", "secret_type", "passphrase"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_secret_v1.secret_1", "payload", "foobar"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_secret_v1.secret_1", "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyManagerSecretV1DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find secret data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Secret data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccKeyManagerSecretV1DataSource_basic(secretName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_keymanager_secret_v1" "secret_1" {
+  name = "${openstack_keymanager_secret_v1.secret_1.name}"
+}
+`, testAccKeyManagerSecretV1_basic(secretName))
+}
@@ -0,0 +1,94 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/rules"
+)
+
+func dataSourceNetworkingQoSMinimumBandwidthRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingQoSMinimumBandwidthRuleV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"qos_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"min_kbps": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"direction": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingQoSMinimumBandwidthRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := rules.MinimumBandwidthRulesListOpts{}
+
+	if v, ok := d.GetOk("min_kbps"); ok {
+		listOpts.MinKBps = v.(int)
+	}
+
+	if v, ok := d.GetOk("direction"); ok {
+		listOpts.Direction = v.(string)
+	}
+
+	qosPolicyID := d.Get("qos_policy_id").(string)
+
+	pages, err := rules.ListMinimumBandwidthRules(networkingClient, qosPolicyID, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_networking_qos_minimum_bandwidth_rule_v2: %s", err)
+	}
+
+	allRules, err := rules.ExtractMinimumBandwidthRules(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract openstack_networking_qos_minimum_bandwidth_rule_v2: %s", err)
+	}
+
+	if len(allRules) < 1 {
+		return fmt.Errorf("Your query returned no openstack_networking_qos_minimum_bandwidth_rule_v2. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allRules) > 1 {
+		return fmt.Errorf("Your query returned more than one openstack_networking_qos_minimum_bandwidth_rule_v2." +
+			" Please try a more specific search criteria")
+	}
+
+	rule := allRules[0]
+	id := resourceNetworkingQoSRuleV2BuildID(qosPolicyID, rule.ID)
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_qos_minimum_bandwidth_rule_v2 %s: %+v", id, rule)
+	d.SetId(id)
+
+	d.Set("qos_policy_id", qosPolicyID)
+	d.Set("min_kbps", rule.MinKBps)
+	d.Set("direction", rule.Direction)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
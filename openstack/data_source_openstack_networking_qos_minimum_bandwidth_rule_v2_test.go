@@ -0,0 +1,69 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2QoSMinimumBandwidthRuleDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackNetworkingQoSMinimumBandwidthRuleV2DataSourceMinBWRule,
+			},
+			{
+				Config: testAccOpenStackNetworkingQoSMinimumBandwidthRuleV2DataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingQoSMinimumBandwidthRuleV2DataSourceID("data.openstack_networking_qos_minimum_bandwidth_rule_v2.min_bw_rule_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_minimum_bandwidth_rule_v2.min_bw_rule_1", "min_kbps", "3000"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_minimum_bandwidth_rule_v2.min_bw_rule_1", "direction", "egress"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingQoSMinimumBandwidthRuleV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find QoS minimum bandwidth rule data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("QoS minimum bandwidth rule data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackNetworkingQoSMinimumBandwidthRuleV2DataSourceMinBWRule = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_minimum_bandwidth_rule_v2" "min_bw_rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  min_kbps      = 3000
+}
+`
+
+var testAccOpenStackNetworkingQoSMinimumBandwidthRuleV2DataSourceBasic = fmt.Sprintf(`
+%s
+
+data "openstack_networking_qos_minimum_bandwidth_rule_v2" "min_bw_rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  min_kbps      = "${openstack_networking_qos_minimum_bandwidth_rule_v2.min_bw_rule_1.min_kbps}"
+}
+`, testAccOpenStackNetworkingQoSMinimumBandwidthRuleV2DataSourceMinBWRule)
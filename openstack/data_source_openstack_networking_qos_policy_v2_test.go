@@ -0,0 +1,117 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2QoSPolicyDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackNetworkingQoSPolicyV2DataSourceQoSPolicy,
+			},
+			{
+				Config: testAccOpenStackNetworkingQoSPolicyV2DataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingQoSPolicyV2DataSourceID("data.openstack_networking_qos_policy_v2.qos_policy_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_policy_v2.qos_policy_1", "name", "qos_policy_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_policy_v2.qos_policy_1", "description", "terraform qos policy acceptance test"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_policy_v2.qos_policy_1", "all_tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2QoSPolicyDataSourceTestQueries(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackNetworkingQoSPolicyV2DataSourceQoSPolicy,
+			},
+			{
+				Config: testAccOpenStackNetworkingQoSPolicyV2DataSourceDescription,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingQoSPolicyV2DataSourceID("data.openstack_networking_qos_policy_v2.qos_policy_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_policy_v2.qos_policy_1", "name", "qos_policy_1"),
+				),
+			},
+			{
+				Config: testAccOpenStackNetworkingQoSPolicyV2DataSourceTags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingQoSPolicyV2DataSourceID("data.openstack_networking_qos_policy_v2.qos_policy_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_policy_v2.qos_policy_1", "tags.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_qos_policy_v2.qos_policy_1", "all_tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingQoSPolicyV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find QoS policy data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("QoS policy data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackNetworkingQoSPolicyV2DataSourceQoSPolicy = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name        = "qos_policy_1"
+  description = "terraform qos policy acceptance test"
+  tags        = ["foo", "bar"]
+}
+`
+
+var testAccOpenStackNetworkingQoSPolicyV2DataSourceBasic = fmt.Sprintf(`
+%s
+
+data "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "${openstack_networking_qos_policy_v2.qos_policy_1.name}"
+}
+`, testAccOpenStackNetworkingQoSPolicyV2DataSourceQoSPolicy)
+
+var testAccOpenStackNetworkingQoSPolicyV2DataSourceDescription = fmt.Sprintf(`
+%s
+
+data "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  description = "${openstack_networking_qos_policy_v2.qos_policy_1.description}"
+}
+`, testAccOpenStackNetworkingQoSPolicyV2DataSourceQoSPolicy)
+
+var testAccOpenStackNetworkingQoSPolicyV2DataSourceTags = fmt.Sprintf(`
+%s
+
+data "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "${openstack_networking_qos_policy_v2.qos_policy_1.name}"
+  tags = ["bar"]
+}
+`, testAccOpenStackNetworkingQoSPolicyV2DataSourceQoSPolicy)
@@ -0,0 +1,121 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetworkingQuotaV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingQuotaV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"floatingip": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"network": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"rbac_policy": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"router": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"security_group": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"security_group_rule": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"subnet": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"subnetpool": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"in_use": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"reserved": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingQuotaV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+
+	details, err := networkingQuotaV2GetDetails(networkingClient, projectID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_networking_quota_v2 %s: %s", projectID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_quota_v2 %s: %#v", projectID, details)
+
+	d.SetId(projectID)
+
+	// Neutron reports the quotas of all of the enabled extensions, only some
+	// of them have a top-level attribute.
+	inUse := make(map[string]int)
+	reserved := make(map[string]int)
+	for k, v := range details {
+		if strSliceContains(networkingQuotaV2Resources, k) {
+			d.Set(k, v.Limit)
+		}
+		inUse[k] = v.Used
+		reserved[k] = v.Reserved
+	}
+
+	d.Set("in_use", inUse)
+	d.Set("reserved", reserved)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkingV2QuotaDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2QuotaDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_quota_v2.quota_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_quota_v2.quota_1", "floatingip", "10"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_quota_v2.quota_1", "in_use.floatingip", "0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccNetworkingV2QuotaDataSourceBasic = fmt.Sprintf(`
+%s
+
+data "openstack_networking_quota_v2" "quota_1" {
+  project_id = "${openstack_networking_quota_v2.quota_1.project_id}"
+}
+`, testAccNetworkingV2QuotaBasic)
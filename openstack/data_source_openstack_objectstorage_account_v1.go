@@ -0,0 +1,92 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceObjectStorageAccountV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectStorageAccountV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed values.
+			"bytes_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"container_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"quota_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageAccountV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	id, err := objectStorageAccountV1ID(objectStorageClient)
+	if err != nil {
+		return err
+	}
+
+	result := accounts.Get(objectStorageClient, nil)
+	account, err := result.Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_objectstorage_account_v1 %s: %s", id, err)
+	}
+
+	metadata, err := result.ExtractMetadata()
+	if err != nil {
+		return fmt.Errorf("Error retrieving metadata of openstack_objectstorage_account_v1 %s: %s", id, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_objectstorage_account_v1 %s: %d containers, %d objects, %d bytes", id, account.ContainerCount, account.ObjectCount, account.BytesUsed)
+
+	d.SetId(id)
+
+	d.Set("bytes_used", account.BytesUsed)
+	d.Set("container_count", account.ContainerCount)
+	d.Set("object_count", account.ObjectCount)
+	d.Set("region", GetRegion(d, config))
+
+	if account.QuotaBytes != nil {
+		d.Set("quota_bytes", *account.QuotaBytes)
+	}
+
+	if err := d.Set("metadata", objectStorageV1Metadata(objectStorageAccountV1Metadata(metadata), nil)); err != nil {
+		log.Printf("[DEBUG] Unable to set metadata for openstack_objectstorage_account_v1 %s: %s", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccObjectStorageV1AccountDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1Account_basic,
+			},
+			{
+				Config: testAccObjectStorageV1AccountDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageV1AccountDataSourceID("data.openstack_objectstorage_account_v1.account_1"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_objectstorage_account_v1.account_1", "bytes_used"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_objectstorage_account_v1.account_1", "container_count"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_account_v1.account_1", "metadata.test", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStorageV1AccountDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find account data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Account data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccObjectStorageV1AccountDataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_objectstorage_account_v1" "account_1" {
+  depends_on = ["openstack_objectstorage_account_v1.account_1"]
+}
+`, testAccObjectStorageV1Account_basic)
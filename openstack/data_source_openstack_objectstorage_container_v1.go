@@ -0,0 +1,204 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceObjectStorageContainerV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectStorageContainerV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values.
+			"container_read": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"container_write": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"storage_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"versioning": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"quota_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"quota_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"cors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_origins": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expose_headers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"max_age": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageContainerV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+
+	result := containers.Get(objectStorageClient, name, nil)
+	container, err := result.Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_objectstorage_container_v1 %s: %s", name, err)
+	}
+
+	metadata, err := result.ExtractMetadata()
+	if err != nil {
+		return fmt.Errorf("Error retrieving metadata of openstack_objectstorage_container_v1 %s: %s", name, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_objectstorage_container_v1 %s: %#v", name, container)
+
+	metadata, err = objectStorageContainerV1SetTypedMetadata(d, metadata)
+	if err != nil {
+		return fmt.Errorf("Error reading openstack_objectstorage_container_v1 %s: %s", name, err)
+	}
+
+	listOpts := &objects.ListOpts{
+		Prefix: d.Get("prefix").(string),
+	}
+
+	allPages, err := objects.List(objectStorageClient, name, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Error listing objects of openstack_objectstorage_container_v1 %s: %s", name, err)
+	}
+
+	objectNames, err := objects.ExtractNames(allPages)
+	if err != nil {
+		return fmt.Errorf("Error extracting objects of openstack_objectstorage_container_v1 %s: %s", name, err)
+	}
+
+	var versioning []map[string]interface{}
+	switch {
+	case container.VersionsLocation != "":
+		versioning = append(versioning, map[string]interface{}{
+			"type":     "versions",
+			"location": container.VersionsLocation,
+		})
+	case container.HistoryLocation != "":
+		versioning = append(versioning, map[string]interface{}{
+			"type":     "history",
+			"location": container.HistoryLocation,
+		})
+	}
+
+	d.SetId(name)
+
+	d.Set("container_read", strings.Join(container.Read, ","))
+	d.Set("container_write", strings.Join(container.Write, ","))
+	d.Set("content_type", container.ContentType)
+	d.Set("storage_policy", container.StoragePolicy)
+	d.Set("object_count", container.ObjectCount)
+	d.Set("bytes_used", container.BytesUsed)
+	d.Set("objects", objectNames)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("versioning", versioning); err != nil {
+		log.Printf("[DEBUG] Unable to set versioning for openstack_objectstorage_container_v1 %s: %s", name, err)
+	}
+
+	if err := d.Set("metadata", objectStorageV1Metadata(metadata, nil)); err != nil {
+		log.Printf("[DEBUG] Unable to set metadata for openstack_objectstorage_container_v1 %s: %s", name, err)
+	}
+
+	return nil
+}
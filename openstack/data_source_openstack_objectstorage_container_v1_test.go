@@ -0,0 +1,88 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccObjectStorageV1ContainerDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ContainerDataSource_container,
+			},
+			{
+				Config: testAccObjectStorageV1ContainerDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageV1ContainerDataSourceID("data.openstack_objectstorage_container_v1.container_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_container_v1.container_1", "name", "tf_test_container_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_container_v1.container_1", "content_type", "application/json"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_container_v1.container_1", "metadata.test", "true"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_container_v1.container_1", "object_count", "2"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_container_v1.container_1", "objects.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_container_v1.container_1", "objects.0", "manifests/latest.json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStorageV1ContainerDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find container data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Container data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccObjectStorageV1ContainerDataSource_container = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+  metadata = {
+    test = "true"
+  }
+  content_type = "application/json"
+}
+
+resource "openstack_objectstorage_object_v1" "object_1" {
+  name = "manifests/latest.json"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content = "{\"version\": \"1.0.0\"}"
+}
+
+resource "openstack_objectstorage_object_v1" "object_2" {
+  name = "README"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content = "foo"
+}
+`
+
+var testAccObjectStorageV1ContainerDataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_objectstorage_container_v1" "container_1" {
+  name = "${openstack_objectstorage_container_v1.container_1.name}"
+  prefix = "manifests/"
+}
+`, testAccObjectStorageV1ContainerDataSource_container)
@@ -0,0 +1,155 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceObjectStorageObjectV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectStorageObjectV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"container_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Computed values.
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_length": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"content_encoding": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_disposition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"delete_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"object_manifest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"static_large_object": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"trans_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageObjectV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	container := d.Get("container_name").(string)
+	name := d.Get("name").(string)
+
+	result := objects.Get(objectStorageClient, container, name, nil)
+	object, err := result.Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_objectstorage_object_v1 %s/%s: %s", container, name, err)
+	}
+
+	metadata, err := result.ExtractMetadata()
+	if err != nil {
+		return fmt.Errorf("Error retrieving metadata of openstack_objectstorage_object_v1 %s/%s: %s", container, name, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_objectstorage_object_v1 %s/%s: %#v", container, name, object)
+
+	download := objects.Download(objectStorageClient, container, name, nil)
+	content, err := download.ExtractContent()
+	if err != nil {
+		return fmt.Errorf("Error downloading openstack_objectstorage_object_v1 %s/%s: %s", container, name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", container, name))
+
+	d.Set("content", string(content))
+	d.Set("content_type", object.ContentType)
+	d.Set("content_length", object.ContentLength)
+	d.Set("content_encoding", object.ContentEncoding)
+	d.Set("content_disposition", object.ContentDisposition)
+	d.Set("etag", object.ETag)
+	d.Set("last_modified", object.LastModified.Format(time.RFC3339))
+	d.Set("date", object.Date.Format(time.RFC3339))
+	d.Set("object_manifest", object.ObjectManifest)
+	d.Set("static_large_object", object.StaticLargeObject)
+	d.Set("trans_id", object.TransID)
+	d.Set("region", GetRegion(d, config))
+
+	if !object.DeleteAt.IsZero() {
+		d.Set("delete_at", object.DeleteAt.Format(time.RFC3339))
+	}
+
+	if err := d.Set("metadata", objectStorageV1Metadata(metadata, nil)); err != nil {
+		log.Printf("[DEBUG] Unable to set metadata for openstack_objectstorage_object_v1 %s/%s: %s", container, name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccObjectStorageV1ObjectDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ObjectDataSource_object,
+			},
+			{
+				Config: testAccObjectStorageV1ObjectDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageV1ObjectDataSourceID("data.openstack_objectstorage_object_v1.object_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.object_1", "content", "{\"version\": \"1.0.0\"}"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.object_1", "content_type", "application/json"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.object_1", "content_length", "20"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.object_1", "metadata.foo", "bar"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.object_1", "static_large_object", "false"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_objectstorage_object_v1.object_1", "etag",
+						"openstack_objectstorage_object_v1.object_1", "etag"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStorageV1ObjectDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find object data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Object data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccObjectStorageV1ObjectDataSource_object = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+}
+
+resource "openstack_objectstorage_object_v1" "object_1" {
+  name = "manifests/latest.json"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content = "{\"version\": \"1.0.0\"}"
+  content_type = "application/json"
+  metadata = {
+    foo = "bar"
+  }
+}
+`
+
+var testAccObjectStorageV1ObjectDataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_objectstorage_object_v1" "object_1" {
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  name = "${openstack_objectstorage_object_v1.object_1.name}"
+}
+`, testAccObjectStorageV1ObjectDataSource_object)
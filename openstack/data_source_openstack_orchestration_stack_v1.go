@@ -0,0 +1,208 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stackresources"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceOrchestrationStackV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceOrchestrationStackV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"stack_id"},
+			},
+
+			"stack_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"nested_depth": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"disable_rollback": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"physical_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOrchestrationStackV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	listOpts := stacks.ListOpts{
+		ID:   d.Get("stack_id").(string),
+		Name: d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_orchestration_stack_v1 list options: %#v", listOpts)
+
+	pages, err := stacks.List(orchestrationClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query openstack_orchestration_stack_v1: %s", err)
+	}
+
+	allStacks, err := stacks.ExtractStacks(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_orchestration_stack_v1: %s", err)
+	}
+
+	if len(allStacks) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allStacks) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	// The outputs and parameters are only returned when retrieving a single
+	// stack.
+	stack, err := stacks.Get(orchestrationClient, allStacks[0].Name, allStacks[0].ID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_orchestration_stack_v1 %s: %s", allStacks[0].ID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_orchestration_stack_v1 %s: %#v", stack.ID, stack)
+	d.SetId(stack.ID)
+
+	resourcesOpts := stackresources.ListOpts{
+		Depth: d.Get("nested_depth").(int),
+	}
+
+	resourcePages, err := stackresources.List(orchestrationClient, stack.Name, stack.ID, resourcesOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query resources of openstack_orchestration_stack_v1 %s: %s", stack.ID, err)
+	}
+
+	allResources, err := stackresources.ExtractResources(resourcePages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve resources of openstack_orchestration_stack_v1 %s: %s", stack.ID, err)
+	}
+
+	d.Set("name", stack.Name)
+	d.Set("stack_id", stack.ID)
+	d.Set("description", stack.Description)
+	d.Set("disable_rollback", stack.DisableRollback)
+	d.Set("timeout", stack.Timeout)
+	d.Set("tags", stack.Tags)
+	d.Set("parameters", flattenOrchestrationStackV1Parameters(stack.Parameters))
+	d.Set("outputs", flattenOrchestrationStackV1Outputs(stack.Outputs))
+	d.Set("status", stack.Status)
+	d.Set("status_reason", stack.StatusReason)
+	d.Set("created_at", stack.CreationTime.Format(time.RFC3339))
+	d.Set("updated_at", stack.UpdatedTime.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("resources", flattenOrchestrationStackV1Resources(allResources)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_orchestration_stack_v1 %s resources: %s", stack.ID, err)
+	}
+
+	return nil
+}
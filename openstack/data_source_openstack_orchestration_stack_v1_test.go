@@ -0,0 +1,66 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOrchestrationV1StackDataSource_basic(t *testing.T) {
+	stackName := fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckOrchestration(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrchestrationV1Stack_basic(stackName),
+			},
+			{
+				Config: testAccOrchestrationV1StackDataSource_basic(stackName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackDataSourceID("data.openstack_orchestration_stack_v1.stack_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_orchestration_stack_v1.stack_1", "name", stackName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_orchestration_stack_v1.stack_1", "parameters.length", "4"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_orchestration_stack_v1.stack_1", "outputs.value",
+						"openstack_orchestration_stack_v1.stack_1", "outputs.value"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_orchestration_stack_v1.stack_1", "resources.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_orchestration_stack_v1.stack_1", "resources.0.type", "OS::Heat::RandomString"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrchestrationV1StackDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find stack data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Stack data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccOrchestrationV1StackDataSource_basic(stackName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_orchestration_stack_v1" "stack_1" {
+  name = "${openstack_orchestration_stack_v1.stack_1.name}"
+}
+`, testAccOrchestrationV1Stack_basic(stackName))
+}
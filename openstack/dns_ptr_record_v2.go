@@ -0,0 +1,96 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// dnsPTRRecordV2 represents the PTR record of a floating IP managed by
+// Designate. Gophercloud doesn't support reverse DNS yet.
+type dnsPTRRecordV2 struct {
+	ID          string `json:"id"`
+	PTRDName    string `json:"ptrdname"`
+	Description string `json:"description"`
+	TTL         int    `json:"ttl"`
+	Address     string `json:"address"`
+	Status      string `json:"status"`
+	Action      string `json:"action"`
+}
+
+// dnsPTRRecordV2SetOpts represents the attributes used when setting the
+// PTR record of a floating IP.
+type dnsPTRRecordV2SetOpts struct {
+	PTRDName    string  `json:"ptrdname" required:"true"`
+	Description *string `json:"description,omitempty"`
+	TTL         int     `json:"ttl,omitempty"`
+}
+
+// PTR records are identified by the region and the ID of the floating IP.
+func dnsPTRRecordV2ID(region, floatingIPID string) string {
+	return fmt.Sprintf("%s:%s", region, floatingIPID)
+}
+
+func dnsPTRRecordV2ParseID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine openstack_dns_ptr_record_v2 ID from raw ID: %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+func dnsPTRRecordV2Get(client *gophercloud.ServiceClient, id string) (*dnsPTRRecordV2, error) {
+	var r dnsPTRRecordV2
+	_, err := client.Get(client.ServiceURL("reverse", "floatingips", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+func dnsPTRRecordV2Set(client *gophercloud.ServiceClient, id string, opts dnsPTRRecordV2SetOpts) (*dnsPTRRecordV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var r dnsPTRRecordV2
+	_, err = client.Patch(client.ServiceURL("reverse", "floatingips", id), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// dnsPTRRecordV2Unset removes the PTR record of a floating IP.
+func dnsPTRRecordV2Unset(client *gophercloud.ServiceClient, id string) error {
+	b := map[string]interface{}{
+		"ptrdname": nil,
+	}
+
+	_, err := client.Patch(client.ServiceURL("reverse", "floatingips", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+
+	return err
+}
+
+func dnsPTRRecordV2RefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ptr, err := dnsPTRRecordV2Get(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] openstack_dns_ptr_record_v2 %s current status: %s", id, ptr.Status)
+		return ptr, ptr.Status, nil
+	}
+}
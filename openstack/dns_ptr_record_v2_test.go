@@ -0,0 +1,107 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDNSPTRRecordV2Response = `
+{
+  "id": "RegionOne:fip",
+  "ptrdname": "smtp.example.com.",
+  "description": "mail server",
+  "ttl": 600,
+  "address": "172.24.4.10",
+  "status": "PENDING",
+  "action": "CREATE"
+}`
+
+var testDNSPTRRecordV2 = &dnsPTRRecordV2{
+	ID:          "RegionOne:fip",
+	PTRDName:    "smtp.example.com.",
+	Description: "mail server",
+	TTL:         600,
+	Address:     "172.24.4.10",
+	Status:      "PENDING",
+	Action:      "CREATE",
+}
+
+func TestDNSPTRRecordV2ParseID(t *testing.T) {
+	region, floatingIPID, err := dnsPTRRecordV2ParseID("RegionOne:fip")
+	assert.NoError(t, err)
+	assert.Equal(t, "RegionOne", region)
+	assert.Equal(t, "fip", floatingIPID)
+
+	for _, id := range []string{"fip", "RegionOne:", ":fip"} {
+		_, _, err := dnsPTRRecordV2ParseID(id)
+		assert.Error(t, err)
+	}
+}
+
+func TestDNSPTRRecordV2Get(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/reverse/floatingips/RegionOne:fip", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, testDNSPTRRecordV2Response)
+	})
+
+	actual, err := dnsPTRRecordV2Get(thclient.ServiceClient(), "RegionOne:fip")
+
+	assert.NoError(t, err)
+	assert.Equal(t, testDNSPTRRecordV2, actual)
+}
+
+func TestDNSPTRRecordV2Set(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/reverse/floatingips/RegionOne:fip", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestJSONRequest(t, r, `
+{
+  "ptrdname": "smtp.example.com.",
+  "description": "mail server",
+  "ttl": 600
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, testDNSPTRRecordV2Response)
+	})
+
+	description := "mail server"
+	actual, err := dnsPTRRecordV2Set(thclient.ServiceClient(), "RegionOne:fip", dnsPTRRecordV2SetOpts{
+		PTRDName:    "smtp.example.com.",
+		Description: &description,
+		TTL:         600,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, testDNSPTRRecordV2, actual)
+}
+
+func TestDNSPTRRecordV2Unset(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/reverse/floatingips/RegionOne:fip", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestJSONRequest(t, r, `{"ptrdname": null}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := dnsPTRRecordV2Unset(thclient.ServiceClient(), "RegionOne:fip")
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,144 @@
+package openstack
+
+import (
+	"log"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// dnsTransferRequestV2 represents a Designate zone transfer request.
+// Gophercloud doesn't support zone transfers yet.
+type dnsTransferRequestV2 struct {
+	ID              string `json:"id"`
+	ZoneID          string `json:"zone_id"`
+	ZoneName        string `json:"zone_name"`
+	ProjectID       string `json:"project_id"`
+	TargetProjectID string `json:"target_project_id"`
+	Key             string `json:"key"`
+	Description     string `json:"description"`
+	Status          string `json:"status"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+// dnsTransferRequestV2CreateOpts represents the attributes used when
+// creating a new zone transfer request.
+type dnsTransferRequestV2CreateOpts struct {
+	TargetProjectID string `json:"target_project_id,omitempty"`
+	Description     string `json:"description,omitempty"`
+}
+
+// dnsTransferRequestV2UpdateOpts represents the attributes used when
+// updating an existing zone transfer request.
+type dnsTransferRequestV2UpdateOpts struct {
+	TargetProjectID *string `json:"target_project_id,omitempty"`
+	Description     *string `json:"description,omitempty"`
+}
+
+// dnsTransferAcceptV2 represents the acceptance of a Designate zone transfer
+// request.
+type dnsTransferAcceptV2 struct {
+	ID                    string `json:"id"`
+	ZoneID                string `json:"zone_id"`
+	ProjectID             string `json:"project_id"`
+	ZoneTransferRequestID string `json:"zone_transfer_request_id"`
+	Key                   string `json:"key"`
+	Status                string `json:"status"`
+	CreatedAt             string `json:"created_at"`
+	UpdatedAt             string `json:"updated_at"`
+}
+
+// dnsTransferAcceptV2CreateOpts represents the attributes used when
+// accepting a zone transfer request.
+type dnsTransferAcceptV2CreateOpts struct {
+	ZoneTransferRequestID string `json:"zone_transfer_request_id"`
+	Key                   string `json:"key"`
+}
+
+func dnsTransferRequestV2Create(client *gophercloud.ServiceClient, zoneID string, opts dnsTransferRequestV2CreateOpts) (*dnsTransferRequestV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var r dnsTransferRequestV2
+	_, err = client.Post(client.ServiceURL("zones", zoneID, "tasks", "transfer_requests"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+func dnsTransferRequestV2Get(client *gophercloud.ServiceClient, id string) (*dnsTransferRequestV2, error) {
+	var r dnsTransferRequestV2
+	_, err := client.Get(client.ServiceURL("zones", "tasks", "transfer_requests", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+func dnsTransferRequestV2Update(client *gophercloud.ServiceClient, id string, opts dnsTransferRequestV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Patch(client.ServiceURL("zones", "tasks", "transfer_requests", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func dnsTransferRequestV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("zones", "tasks", "transfer_requests", id), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+
+	return err
+}
+
+func dnsTransferAcceptV2Create(client *gophercloud.ServiceClient, opts dnsTransferAcceptV2CreateOpts) (*dnsTransferAcceptV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var r dnsTransferAcceptV2
+	_, err = client.Post(client.ServiceURL("zones", "tasks", "transfer_accepts"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+func dnsTransferAcceptV2Get(client *gophercloud.ServiceClient, id string) (*dnsTransferAcceptV2, error) {
+	var r dnsTransferAcceptV2
+	_, err := client.Get(client.ServiceURL("zones", "tasks", "transfer_accepts", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+func dnsTransferAcceptV2RefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		accept, err := dnsTransferAcceptV2Get(client, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] openstack_dns_transfer_accept_v2 %s current status: %s", accept.ID, accept.Status)
+		return accept, accept.Status, nil
+	}
+}
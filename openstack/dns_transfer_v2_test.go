@@ -0,0 +1,165 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDNSTransferRequestV2Response = `
+{
+  "id": "request",
+  "zone_id": "zone",
+  "zone_name": "example.com.",
+  "project_id": "project",
+  "target_project_id": "target",
+  "key": "9Z2R50Y0",
+  "description": "transfer",
+  "status": "ACTIVE",
+  "created_at": "2019-11-20T10:00:00.000000",
+  "updated_at": null
+}`
+
+var testDNSTransferRequestV2 = &dnsTransferRequestV2{
+	ID:              "request",
+	ZoneID:          "zone",
+	ZoneName:        "example.com.",
+	ProjectID:       "project",
+	TargetProjectID: "target",
+	Key:             "9Z2R50Y0",
+	Description:     "transfer",
+	Status:          "ACTIVE",
+	CreatedAt:       "2019-11-20T10:00:00.000000",
+}
+
+func TestDNSTransferRequestV2Create(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/zones/zone/tasks/transfer_requests", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "target_project_id": "target",
+  "description": "transfer"
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, testDNSTransferRequestV2Response)
+	})
+
+	actual, err := dnsTransferRequestV2Create(thclient.ServiceClient(), "zone", dnsTransferRequestV2CreateOpts{
+		TargetProjectID: "target",
+		Description:     "transfer",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, testDNSTransferRequestV2, actual)
+}
+
+func TestDNSTransferRequestV2Get(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/zones/tasks/transfer_requests/request", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, testDNSTransferRequestV2Response)
+	})
+
+	actual, err := dnsTransferRequestV2Get(thclient.ServiceClient(), "request")
+
+	assert.NoError(t, err)
+	assert.Equal(t, testDNSTransferRequestV2, actual)
+}
+
+func TestDNSTransferRequestV2Update(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/zones/tasks/transfer_requests/request", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestJSONRequest(t, r, `
+{
+  "description": ""
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, testDNSTransferRequestV2Response)
+	})
+
+	description := ""
+	err := dnsTransferRequestV2Update(thclient.ServiceClient(), "request", dnsTransferRequestV2UpdateOpts{
+		Description: &description,
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestDNSTransferRequestV2Delete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/zones/tasks/transfer_requests/request", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := dnsTransferRequestV2Delete(thclient.ServiceClient(), "request")
+
+	assert.NoError(t, err)
+}
+
+func TestDNSTransferAcceptV2Create(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/zones/tasks/transfer_accepts", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "zone_transfer_request_id": "request",
+  "key": "9Z2R50Y0"
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `
+{
+  "id": "accept",
+  "zone_id": "zone",
+  "project_id": "target",
+  "zone_transfer_request_id": "request",
+  "key": "9Z2R50Y0",
+  "status": "COMPLETE",
+  "created_at": "2019-11-20T10:00:00.000000",
+  "updated_at": null
+}`)
+	})
+
+	expected := &dnsTransferAcceptV2{
+		ID:                    "accept",
+		ZoneID:                "zone",
+		ProjectID:             "target",
+		ZoneTransferRequestID: "request",
+		Key:                   "9Z2R50Y0",
+		Status:                "COMPLETE",
+		CreatedAt:             "2019-11-20T10:00:00.000000",
+	}
+
+	actual, err := dnsTransferAcceptV2Create(thclient.ServiceClient(), dnsTransferAcceptV2CreateOpts{
+		ZoneTransferRequestID: "request",
+		Key:                   "9Z2R50Y0",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,48 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Image members have no ID in OpenStack.
+// Build an ID out of the image ID and the member (project) ID.
+func imagesImageAccessV2ID(imageID, memberID string) string {
+	return fmt.Sprintf("%s/%s", imageID, memberID)
+}
+
+func imagesImageAccessV2ParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine image access ID from %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+// imagesImageAccessV2DetectMemberID returns the member_id of the
+// configuration, or the ID of the project the provider is scoped to.
+func imagesImageAccessV2DetectMemberID(d *schema.ResourceData, config *Config) (string, error) {
+	if v := d.Get("member_id").(string); v != "" {
+		return v, nil
+	}
+
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return "", fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	project, err := tokens.Get(identityClient, config.OsClient.TokenID).ExtractProject()
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving the project of the current token: %s", err)
+	}
+
+	if project == nil || project.ID == "" {
+		return "", fmt.Errorf("Unable to determine the project of the current token, member_id must be set")
+	}
+
+	return project.ID, nil
+}
@@ -0,0 +1,21 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImagesImageAccessV2ParseID(t *testing.T) {
+	imageID, memberID, err := imagesImageAccessV2ParseID("image/member")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "image", imageID)
+	assert.Equal(t, "member", memberID)
+
+	_, _, err = imagesImageAccessV2ParseID("image")
+	assert.Error(t, err)
+
+	_, _, err = imagesImageAccessV2ParseID("image/")
+	assert.Error(t, err)
+}
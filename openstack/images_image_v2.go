@@ -0,0 +1,251 @@
+package openstack
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/tasks"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// imagesImageV2ImportTaskProperty is set by Glance on an image with the ID
+	// of the task processing the current import.
+	imagesImageV2ImportTaskProperty = "os_glance_import_task"
+
+	// imagesImageV2FailedImportProperty is set by Glance on an image with the
+	// stores that failed to import the image data.
+	imagesImageV2FailedImportProperty = "os_glance_failed_import"
+
+	// imagesImageV2StatusUploading is the status of an image whose data has
+	// been staged with the glance-direct import method.
+	imagesImageV2StatusUploading = "uploading"
+
+	// imagesImageV2HashAlgoProperty and imagesImageV2HashValueProperty are
+	// set by Glance on an image with the secure hash of the image data.
+	imagesImageV2HashAlgoProperty  = "os_hash_algo"
+	imagesImageV2HashValueProperty = "os_hash_value"
+)
+
+// imagesImageV2Import starts an interoperable image import of the data of
+// the image. The uri is only used by the web-download import method.
+func imagesImageV2Import(imageClient *gophercloud.ServiceClient, id string, method string, uri string) error {
+	importOpts := ImageImportCreateOpts{
+		Name: imageimport.ImportMethod(method),
+	}
+
+	if importOpts.Name == imageimport.WebDownloadMethod {
+		importOpts.URI = uri
+	}
+
+	log.Printf("[DEBUG] openstack_images_image_v2 %s import options: %#v", id, importOpts)
+
+	return imageimport.Create(imageClient, id, importOpts).ExtractErr()
+}
+
+// imagesImageV2ImportRefreshFunc returns the status of an image being
+// imported. It returns an error when the import task of the image failed.
+func imagesImageV2ImportRefreshFunc(imageClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		img, err := images.Get(imageClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenStack image %s status is: %s", id, img.Status)
+
+		if img.Status == images.ImageStatusKilled {
+			return img, string(img.Status), fmt.Errorf("Image %s import was killed", id)
+		}
+
+		if v, ok := img.Properties[imagesImageV2FailedImportProperty].(string); ok && v != "" {
+			return img, string(img.Status), fmt.Errorf("Image %s import failed for stores: %s", id, v)
+		}
+
+		taskID, ok := img.Properties[imagesImageV2ImportTaskProperty].(string)
+		if !ok || taskID == "" || img.Status == images.ImageStatusActive {
+			return img, string(img.Status), nil
+		}
+
+		task, err := tasks.Get(imageClient, taskID).Extract()
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving import task %s of image %s: %s", taskID, id, err)
+		}
+
+		log.Printf("[DEBUG] OpenStack image %s import task %s status is: %s", id, taskID, task.Status)
+
+		if task.Status == string(tasks.TaskStatusFailure) {
+			return img, string(img.Status), fmt.Errorf("Image %s import task %s failed: %s", id, taskID, task.Message)
+		}
+
+		return img, string(img.Status), nil
+	}
+}
+
+// imagesImageV2Hashes computes the secure hashes supported by Glance of the
+// image data written to it.
+type imagesImageV2Hashes struct {
+	hashes map[string]hash.Hash
+	writer io.Writer
+	size   int64
+}
+
+func newImagesImageV2Hashes() *imagesImageV2Hashes {
+	h := &imagesImageV2Hashes{
+		hashes: map[string]hash.Hash{
+			"sha256": sha256.New(),
+			"sha384": sha512.New384(),
+			"sha512": sha512.New(),
+		},
+	}
+
+	writers := make([]io.Writer, 0, len(h.hashes))
+	for _, v := range h.hashes {
+		writers = append(writers, v)
+	}
+	h.writer = io.MultiWriter(writers...)
+
+	return h
+}
+
+func (h *imagesImageV2Hashes) Write(p []byte) (int, error) {
+	n, err := h.writer.Write(p)
+	h.size += int64(n)
+	return n, err
+}
+
+// Reset discards the image data hashed so far.
+func (h *imagesImageV2Hashes) Reset() {
+	for _, v := range h.hashes {
+		v.Reset()
+	}
+	h.size = 0
+}
+
+// Value returns the hash of the image data for the given algorithm.
+func (h *imagesImageV2Hashes) Value(algo string) (string, bool) {
+	v, ok := h.hashes[algo]
+	if !ok {
+		return "", false
+	}
+
+	return hex.EncodeToString(v.Sum(nil)), true
+}
+
+// imagesImageV2Reader hashes the image data while it is read. It implements
+// io.Seeker so that gophercloud rewinds the image data instead of resending
+// an already consumed reader when it retries a request after reauthenticating.
+type imagesImageV2Reader struct {
+	source io.Reader
+	hashes *imagesImageV2Hashes
+	err    error
+}
+
+func newImagesImageV2Reader(source io.Reader) *imagesImageV2Reader {
+	return &imagesImageV2Reader{
+		source: source,
+		hashes: newImagesImageV2Hashes(),
+	}
+}
+
+func (r *imagesImageV2Reader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	n, err := r.source.Read(p)
+	r.hashes.Write(p[:n])
+	return n, err
+}
+
+// Seek only supports rewinding the image data to its start, which also
+// resets the hashes. Once a source which can't be rewound, like the body of
+// an image_source_url download, has been read from, every further read fails
+// rather than sending truncated image data.
+func (r *imagesImageV2Reader) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekStart {
+		return 0, fmt.Errorf("Error seeking image data: only rewinding is supported")
+	}
+
+	if r.hashes.size == 0 {
+		return 0, nil
+	}
+
+	seeker, ok := r.source.(io.Seeker)
+	if !ok {
+		r.err = fmt.Errorf("Error rewinding image data: the image source can't be read twice")
+		return 0, r.err
+	}
+
+	if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+		r.err = fmt.Errorf("Error rewinding image data: %s", err)
+		return 0, r.err
+	}
+
+	r.hashes.Reset()
+
+	return 0, nil
+}
+
+// imagesImageV2Verify compares the hash Glance computed of the image data with
+// the one computed while uploading it. The legacy MD5 checksum is not
+// considered, so an image without a secure hash can't be verified.
+func imagesImageV2Verify(img *images.Image, h *imagesImageV2Hashes) error {
+	algo, _ := img.Properties[imagesImageV2HashAlgoProperty].(string)
+	if algo == "" {
+		return fmt.Errorf("Error image %s has no %s property, set verify_checksum to false to skip the verification", img.ID, imagesImageV2HashAlgoProperty)
+	}
+
+	expected, ok := h.Value(algo)
+	if !ok {
+		return fmt.Errorf("Error unsupported %s %q of image %s", imagesImageV2HashAlgoProperty, algo, img.ID)
+	}
+
+	value, _ := img.Properties[imagesImageV2HashValueProperty].(string)
+	if value != expected {
+		return fmt.Errorf("Error wrong %s hash: got %q, expected %q", algo, value, expected)
+	}
+
+	return nil
+}
+
+// imagesImageV2Source opens the image data of local_file_path or
+// image_source_url. The data is streamed and never copied to a local file.
+func imagesImageV2Source(d *schema.ResourceData) (io.ReadCloser, error) {
+	if filename := d.Get("local_file_path").(string); filename != "" {
+		file, err := os.Open(filename)
+		if err != nil {
+			return nil, fmt.Errorf("Error opening file %q: %s", filename, err)
+		}
+		return file, nil
+	}
+
+	furl := d.Get("image_source_url").(string)
+	if furl == "" {
+		return nil, fmt.Errorf("Error in config. no file specified")
+	}
+
+	log.Printf("[DEBUG] Streaming image from %s", furl)
+	resp, err := http.Get(furl)
+	if err != nil {
+		return nil, fmt.Errorf("Error downloading image from %q: %s", furl, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("Error downloading image from %q: %s", furl, resp.Status)
+	}
+
+	return resp.Body, nil
+}
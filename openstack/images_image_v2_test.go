@@ -0,0 +1,210 @@
+package openstack
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImagesImageV2ImportWebDownload(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/images/image/import", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "method": {
+    "name": "web-download",
+    "uri": "http://example.com/image.img"
+  }
+}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := imagesImageV2Import(thclient.ServiceClient(), "image", "web-download", "http://example.com/image.img")
+
+	assert.NoError(t, err)
+}
+
+func TestImagesImageV2ImportGlanceDirect(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/images/image/import", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "method": {
+    "name": "glance-direct"
+  }
+}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := imagesImageV2Import(thclient.ServiceClient(), "image", "glance-direct", "http://example.com/image.img")
+
+	assert.NoError(t, err)
+}
+
+func TestImagesImageV2ImportRefreshFuncTaskFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/images/image", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "id": "image",
+  "name": "image_1",
+  "status": "importing",
+  "os_glance_import_task": "task"
+}`)
+	})
+
+	th.Mux.HandleFunc("/tasks/task", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "id": "task",
+  "type": "api_image_import",
+  "status": "failure",
+  "message": "download failed"
+}`)
+	})
+
+	_, status, err := imagesImageV2ImportRefreshFunc(thclient.ServiceClient(), "image")()
+
+	assert.Equal(t, "importing", status)
+	assert.EqualError(t, err, "Image image import task task failed: download failed")
+}
+
+func TestImagesImageV2ImportRefreshFuncImporting(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/images/image", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "id": "image",
+  "name": "image_1",
+  "status": "importing",
+  "os_glance_import_task": "task"
+}`)
+	})
+
+	th.Mux.HandleFunc("/tasks/task", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "id": "task",
+  "type": "api_image_import",
+  "status": "processing"
+}`)
+	})
+
+	_, status, err := imagesImageV2ImportRefreshFunc(thclient.ServiceClient(), "image")()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "importing", status)
+}
+
+func TestImagesImageV2HashesVerify(t *testing.T) {
+	hashes := newImagesImageV2Hashes()
+	_, err := hashes.Write([]byte("image data"))
+	assert.NoError(t, err)
+
+	sha512Value, ok := hashes.Value("sha512")
+	assert.True(t, ok)
+
+	img := &images.Image{
+		ID: "image",
+		Properties: map[string]interface{}{
+			"os_hash_algo":  "sha512",
+			"os_hash_value": sha512Value,
+		},
+	}
+	assert.NoError(t, imagesImageV2Verify(img, hashes))
+
+	img.Properties["os_hash_value"] = "foo"
+	assert.Error(t, imagesImageV2Verify(img, hashes))
+
+	img.Properties["os_hash_algo"] = "foo"
+	assert.Error(t, imagesImageV2Verify(img, hashes))
+}
+
+func TestImagesImageV2HashesVerifyMissingHashAlgo(t *testing.T) {
+	hashes := newImagesImageV2Hashes()
+	_, err := hashes.Write([]byte("image data"))
+	assert.NoError(t, err)
+
+	img := &images.Image{
+		ID:       "image",
+		Checksum: "e09a574ca3760a3e28a3e5920fe4627e",
+	}
+	assert.Error(t, imagesImageV2Verify(img, hashes))
+}
+
+func TestImagesImageV2ReaderRewind(t *testing.T) {
+	expected := newImagesImageV2Hashes()
+	_, err := expected.Write([]byte("image data"))
+	assert.NoError(t, err)
+
+	r := newImagesImageV2Reader(strings.NewReader("image data"))
+
+	_, err = ioutil.ReadAll(r)
+	assert.NoError(t, err)
+
+	_, err = r.Seek(0, io.SeekStart)
+	assert.NoError(t, err)
+
+	data, err := ioutil.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "image data", string(data))
+	assert.Equal(t, int64(10), r.hashes.size)
+	expectedValue, _ := expected.Value("sha512")
+	value, _ := r.hashes.Value("sha512")
+	assert.Equal(t, expectedValue, value)
+
+	_, err = r.Seek(1, io.SeekStart)
+	assert.Error(t, err)
+}
+
+func TestImagesImageV2ReaderRewindUnseekable(t *testing.T) {
+	r := newImagesImageV2Reader(ioutil.NopCloser(strings.NewReader("image data")))
+
+	_, err := r.Seek(0, io.SeekStart)
+	assert.NoError(t, err)
+
+	_, err = ioutil.ReadAll(r)
+	assert.NoError(t, err)
+
+	_, err = r.Seek(0, io.SeekStart)
+	assert.Error(t, err)
+
+	_, err = r.Read(make([]byte, 1))
+	assert.Error(t, err)
+}
@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBlockStorageV3Backup_importBasic(t *testing.T) {
+	resourceName := "openstack_blockstorage_backup_v3.backup_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckBackup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3BackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3Backup_basic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force",
+				},
+			},
+		},
+	})
+}
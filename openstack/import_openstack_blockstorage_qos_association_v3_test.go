@@ -0,0 +1,34 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBlockStorageV3QoSAssociation_importBasic(t *testing.T) {
+	resourceName := "openstack_blockstorage_qos_association_v3.association_1"
+	qosName := acctest.RandomWithPrefix("tf-acc-qos")
+	typeName := acctest.RandomWithPrefix("tf-acc-type")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3QoSAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3QoSAssociation_basic(qosName, typeName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,30 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBlockStorageV3QuotasetImportBasic(t *testing.T) {
+	resourceName := "openstack_blockstorage_quotaset_v3.quotaset_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3QuotasetBasic,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBlockStorageV3VolumeTypeAccess_importBasic(t *testing.T) {
+	resourceName := "openstack_blockstorage_volume_type_access_v3.access_1"
+	typeName := acctest.RandomWithPrefix("tf-acc-type")
+	projectName := acctest.RandomWithPrefix("tf-acc-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTypeAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTypeAccess_basic(typeName, projectName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
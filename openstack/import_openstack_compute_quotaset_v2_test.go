@@ -0,0 +1,30 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeV2QuotasetImportBasic(t *testing.T) {
+	resourceName := "openstack_compute_quotaset_v2.quotaset_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2QuotasetBasic,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
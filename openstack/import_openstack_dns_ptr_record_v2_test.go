@@ -0,0 +1,29 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDNSV2PTRRecord_importBasic(t *testing.T) {
+	ptrName := randomZoneName()
+	resourceName := "openstack_dns_ptr_record_v2.ptr_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2PTRRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2PTRRecord_basic(ptrName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
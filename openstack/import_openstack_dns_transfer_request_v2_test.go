@@ -0,0 +1,34 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDNSV2TransferRequest_importBasic(t *testing.T) {
+	resourceName := "openstack_dns_transfer_request_v2.request_1"
+	var zoneName = fmt.Sprintf("ACPTTEST%s.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckDNS(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2TransferRequestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2TransferRequest_basic(zoneName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKeyManagerContainerV1_importBasic(t *testing.T) {
+	containerName := fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+	resourceName := "openstack_keymanager_container_v1.container_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerContainerV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyManagerContainerV1_basic(containerName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccLBV2FlavorProfile_importBasic(t *testing.T) {
+	resourceName := "openstack_lb_flavorprofile_v2.flavorprofile_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckOctavia(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2FlavorProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2FlavorProfile_basic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
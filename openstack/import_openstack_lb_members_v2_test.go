@@ -0,0 +1,28 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccLBV2Members_importBasic(t *testing.T) {
+	resourceName := "openstack_lb_members_v2.members_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOctavia(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2MembersDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccLBV2MembersConfig_basic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
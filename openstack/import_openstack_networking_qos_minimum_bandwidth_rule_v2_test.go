@@ -0,0 +1,30 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkingV2QoSMinimumBandwidthRuleImportBasic(t *testing.T) {
+	resourceName := "openstack_networking_qos_minimum_bandwidth_rule_v2.minimum_bandwidth_rule_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2QoSMinimumBandwidthRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2QoSMinimumBandwidthRuleBasic,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkingV2QoSPolicyImportBasic(t *testing.T) {
+	resourceName := "openstack_networking_qos_policy_v2.qos_policy_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2QoSPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2QoSPolicyBasic,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"value_specs",
+				},
+			},
+		},
+	})
+}
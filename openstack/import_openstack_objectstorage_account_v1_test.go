@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccObjectStorageV1Account_importBasic(t *testing.T) {
+	resourceName := "openstack_objectstorage_account_v1.account_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObjectStorageV1AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1Account_basic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
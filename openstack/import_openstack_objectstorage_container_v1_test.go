@@ -0,0 +1,34 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccObjectStorageV1Container_importBasic(t *testing.T) {
+	resourceName := "openstack_objectstorage_container_v1.container_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObjectStorageV1ContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1Container_acls,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_destroy",
+				},
+			},
+		},
+	})
+}
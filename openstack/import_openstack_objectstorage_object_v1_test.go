@@ -0,0 +1,40 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccObjectStorageV1Object_importBasic(t *testing.T) {
+	resourceName := "openstack_objectstorage_object_v1.myfile"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckObjectStorageV1ObjectDestroy(s, "terraform/test/myfile.txt")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1Object_basic,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"content",
+					"detect_content_type",
+					"date",
+					"trans_id",
+				},
+			},
+		},
+	})
+}
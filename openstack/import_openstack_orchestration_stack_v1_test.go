@@ -0,0 +1,35 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOrchestrationV1Stack_importBasic(t *testing.T) {
+	stackName := fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+	resourceName := "openstack_orchestration_stack_v1.stack_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOrchestration(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOrchestrationV1StackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrchestrationV1Stack_basic(stackName),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"template",
+					"parameters",
+				},
+			},
+		},
+	})
+}
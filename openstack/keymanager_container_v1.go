@@ -0,0 +1,69 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/containers"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func keyManagerContainerV1StateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		container, err := containers.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return container, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		if container.Status == "ERROR" {
+			return container, container.Status, fmt.Errorf("The container is in error status")
+		}
+
+		return container, container.Status, nil
+	}
+}
+
+func expandKeyManagerContainerV1SecretRefs(secretRefs *schema.Set) []containers.SecretRef {
+	l := make([]containers.SecretRef, 0, secretRefs.Len())
+
+	for _, v := range secretRefs.List() {
+		secretRef := v.(map[string]interface{})
+		l = append(l, containers.SecretRef{
+			Name:      secretRef["name"].(string),
+			SecretRef: secretRef["secret_ref"].(string),
+		})
+	}
+
+	return l
+}
+
+func flattenKeyManagerContainerV1SecretRefs(secretRefs []containers.SecretRef) []map[string]interface{} {
+	l := make([]map[string]interface{}, 0, len(secretRefs))
+
+	for _, secretRef := range secretRefs {
+		l = append(l, map[string]interface{}{
+			"name":       secretRef.Name,
+			"secret_ref": secretRef.SecretRef,
+		})
+	}
+
+	return l
+}
+
+func flattenKeyManagerContainerV1Consumers(consumers []containers.ConsumerRef) []map[string]interface{} {
+	l := make([]map[string]interface{}, 0, len(consumers))
+
+	for _, consumer := range consumers {
+		l = append(l, map[string]interface{}{
+			"name": consumer.Name,
+			"url":  consumer.URL,
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,68 @@
+package openstack
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/secrets"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func keyManagerSecretV1StateRefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		secret, err := secrets.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return secret, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		if secret.Status == "ERROR" {
+			return secret, secret.Status, fmt.Errorf("The secret is in error status")
+		}
+
+		return secret, secret.Status, nil
+	}
+}
+
+// keyManagerSecretV1GetPayload retrieves the payload of a secret. The
+// payload is returned with the same encoding it was created with.
+func keyManagerSecretV1GetPayload(client *gophercloud.ServiceClient, id, contentType, contentEncoding string) (string, error) {
+	opts := secrets.GetPayloadOpts{
+		PayloadContentType: contentType,
+	}
+
+	payload, err := secrets.GetPayload(client, id, opts).Extract()
+	if err != nil {
+		return "", err
+	}
+
+	if contentEncoding == "base64" {
+		return base64.StdEncoding.EncodeToString(payload), nil
+	}
+
+	return string(payload), nil
+}
+
+// keyManagerSecretV1PayloadDiffSuppressFunc suppresses the diff of a payload
+// which only differs by surrounding whitespace, since Barbican trims text
+// payloads.
+func keyManagerSecretV1PayloadDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+func keyManagerSecretV1SecretTypes() []string {
+	return []string{
+		string(secrets.SymmetricSecret),
+		string(secrets.PublicSecret),
+		string(secrets.PrivateSecret),
+		string(secrets.PassphraseSecret),
+		string(secrets.CertificateSecret),
+		string(secrets.OpaqueSecret),
+	}
+}
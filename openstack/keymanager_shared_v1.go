@@ -0,0 +1,183 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/acls"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// keyManagerV1ACLSchema returns the schema of the acl argument, which is
+// shared by secrets and containers. Barbican only supports the "read"
+// operation.
+func keyManagerV1ACLSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"read": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"project_access": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  true,
+							},
+
+							"users": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+
+							"created_at": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+
+							"updated_at": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// keyManagerV1ACLDataSourceSchema returns the computed acl attribute of the
+// key manager data sources.
+func keyManagerV1ACLDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"read": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"project_access": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+
+							"users": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+
+							"created_at": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+
+							"updated_at": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandKeyManagerV1ACLs converts the acl argument into a list of
+// acls.SetOpts, one per operation.
+func expandKeyManagerV1ACLs(v interface{}) []acls.SetOpts {
+	var setOpts []acls.SetOpts
+
+	aclList, ok := v.([]interface{})
+	if !ok || len(aclList) == 0 || aclList[0] == nil {
+		return setOpts
+	}
+
+	for aclType, raw := range aclList[0].(map[string]interface{}) {
+		operations, ok := raw.([]interface{})
+		if !ok || len(operations) == 0 || operations[0] == nil {
+			continue
+		}
+
+		operation := operations[0].(map[string]interface{})
+		projectAccess := operation["project_access"].(bool)
+		users := expandToStringSlice(operation["users"].([]interface{}))
+
+		setOpts = append(setOpts, acls.SetOpts{
+			Type:          aclType,
+			ProjectAccess: &projectAccess,
+			Users:         &users,
+		})
+	}
+
+	return setOpts
+}
+
+// keyManagerV1StoredACLs drops the operations, for which Barbican only
+// reports the default ACL since no ACL has been set. These operations have no
+// creation date.
+func keyManagerV1StoredACLs(acl *acls.ACL) *acls.ACL {
+	if acl == nil {
+		return nil
+	}
+
+	stored := acls.ACL{}
+	for aclType, details := range *acl {
+		if !details.Created.IsZero() {
+			stored[aclType] = details
+		}
+	}
+
+	return &stored
+}
+
+// flattenKeyManagerV1ACLs converts an acls.ACL into the acl argument.
+func flattenKeyManagerV1ACLs(acl *acls.ACL) []map[string]interface{} {
+	if acl == nil || len(*acl) == 0 {
+		return []map[string]interface{}{}
+	}
+
+	m := make(map[string]interface{})
+	for aclType, details := range *acl {
+		operation := map[string]interface{}{
+			"project_access": details.ProjectAccess,
+			"users":          details.Users,
+		}
+
+		if !details.Created.IsZero() {
+			operation["created_at"] = details.Created.Format(time.RFC3339)
+		}
+
+		if !details.Updated.IsZero() {
+			operation["updated_at"] = details.Updated.Format(time.RFC3339)
+		}
+
+		m[aclType] = []map[string]interface{}{operation}
+	}
+
+	return []map[string]interface{}{m}
+}
+
+// keyManagerV1ParseRef returns the UUID of a secret or a container from its
+// reference, e.g. https://barbican.example.com/v1/secrets/<uuid>.
+func keyManagerV1ParseRef(ref string) (string, error) {
+	parts := strings.Split(strings.TrimSuffix(ref, "/"), "/")
+	id := parts[len(parts)-1]
+	if id == "" {
+		return "", fmt.Errorf("Unable to determine the ID from the reference %s", ref)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,110 @@
+package openstack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/acls"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandKeyManagerV1ACLs(t *testing.T) {
+	r := resourceKeyManagerSecretV1()
+	d := r.TestResourceData()
+	d.SetId("1")
+	acl := []map[string]interface{}{
+		{
+			"read": []map[string]interface{}{
+				{
+					"project_access": false,
+					"users":          []interface{}{"user1"},
+				},
+			},
+		},
+	}
+	d.Set("acl", acl)
+
+	projectAccess := false
+	users := []string{"user1"}
+	expectedSetOpts := []acls.SetOpts{
+		{
+			Type:          "read",
+			ProjectAccess: &projectAccess,
+			Users:         &users,
+		},
+	}
+
+	actualSetOpts := expandKeyManagerV1ACLs(d.Get("acl"))
+
+	assert.Equal(t, expectedSetOpts, actualSetOpts)
+}
+
+func TestExpandKeyManagerV1ACLsEmpty(t *testing.T) {
+	r := resourceKeyManagerSecretV1()
+	d := r.TestResourceData()
+	d.SetId("1")
+
+	actualSetOpts := expandKeyManagerV1ACLs(d.Get("acl"))
+
+	assert.Empty(t, actualSetOpts)
+}
+
+func TestFlattenKeyManagerV1ACLs(t *testing.T) {
+	created := time.Date(2019, 4, 1, 10, 0, 0, 0, time.UTC)
+	acl := acls.ACL{
+		"read": acls.ACLDetails{
+			ProjectAccess: false,
+			Users:         []string{"user1"},
+			Created:       created,
+		},
+	}
+
+	expectedACL := []map[string]interface{}{
+		{
+			"read": []map[string]interface{}{
+				{
+					"project_access": false,
+					"users":          []string{"user1"},
+					"created_at":     "2019-04-01T10:00:00Z",
+				},
+			},
+		},
+	}
+
+	actualACL := flattenKeyManagerV1ACLs(&acl)
+
+	assert.Equal(t, expectedACL, actualACL)
+}
+
+func TestKeyManagerV1StoredACLs(t *testing.T) {
+	created := time.Date(2019, 4, 1, 10, 0, 0, 0, time.UTC)
+
+	defaultACL := acls.ACL{
+		"read": acls.ACLDetails{
+			ProjectAccess: true,
+		},
+	}
+	assert.Empty(t, flattenKeyManagerV1ACLs(keyManagerV1StoredACLs(&defaultACL)))
+
+	storedACL := acls.ACL{
+		"read": acls.ACLDetails{
+			ProjectAccess: false,
+			Users:         []string{"user1"},
+			Created:       created,
+		},
+	}
+	assert.Equal(t, &storedACL, keyManagerV1StoredACLs(&storedACL))
+}
+
+func TestKeyManagerV1ParseRef(t *testing.T) {
+	id, err := keyManagerV1ParseRef("https://barbican.example.com:9311/v1/secrets/8e1b6ea1-7b8c-4c39-9e9b-64a0c5a0b9f1")
+	assert.NoError(t, err)
+	assert.Equal(t, "8e1b6ea1-7b8c-4c39-9e9b-64a0c5a0b9f1", id)
+
+	id, err = keyManagerV1ParseRef("https://barbican.example.com:9311/v1/containers/0e73b9e1-5e74-4b57-a1ab-27cef6a2d1a2/")
+	assert.NoError(t, err)
+	assert.Equal(t, "0e73b9e1-5e74-4b57-a1ab-27cef6a2d1a2", id)
+
+	_, err = keyManagerV1ParseRef("")
+	assert.Error(t, err)
+}
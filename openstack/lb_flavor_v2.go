@@ -0,0 +1,82 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// lbFlavorV2 represents an Octavia flavor. Gophercloud doesn't support
+// flavors yet.
+type lbFlavorV2 struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	FlavorProfileID string `json:"flavor_profile_id"`
+	Enabled         bool   `json:"enabled"`
+}
+
+// lbFlavorV2CreateOpts represents the attributes used when creating a new
+// Octavia flavor.
+type lbFlavorV2CreateOpts struct {
+	Name            string `json:"name"`
+	Description     string `json:"description,omitempty"`
+	FlavorProfileID string `json:"flavor_profile_id"`
+	Enabled         *bool  `json:"enabled,omitempty"`
+}
+
+// lbFlavorV2UpdateOpts represents the attributes used when updating an
+// existing Octavia flavor.
+type lbFlavorV2UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+func lbFlavorV2Create(client *gophercloud.ServiceClient, opts lbFlavorV2CreateOpts) (*lbFlavorV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "flavor")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		Flavor lbFlavorV2 `json:"flavor"`
+	}
+	_, err = client.Post(client.ServiceURL("lbaas", "flavors"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Flavor, nil
+}
+
+func lbFlavorV2Get(client *gophercloud.ServiceClient, id string) (*lbFlavorV2, error) {
+	var r struct {
+		Flavor lbFlavorV2 `json:"flavor"`
+	}
+	_, err := client.Get(client.ServiceURL("lbaas", "flavors", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.Flavor, nil
+}
+
+func lbFlavorV2Update(client *gophercloud.ServiceClient, id string, opts lbFlavorV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "flavor")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("lbaas", "flavors", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func lbFlavorV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("lbaas", "flavors", id), nil)
+
+	return err
+}
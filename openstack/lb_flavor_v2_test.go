@@ -0,0 +1,79 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLBFlavorV2Create(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/lbaas/flavors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+  "flavor": {
+    "name": "flavor_1",
+    "flavor_profile_id": "profile",
+    "enabled": true
+  }
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `
+{
+  "flavor": {
+    "id": "flavor",
+    "name": "flavor_1",
+    "description": "",
+    "flavor_profile_id": "profile",
+    "enabled": true
+  }
+}`)
+	})
+
+	enabled := true
+	expected := &lbFlavorV2{
+		ID:              "flavor",
+		Name:            "flavor_1",
+		FlavorProfileID: "profile",
+		Enabled:         true,
+	}
+
+	actual, err := lbFlavorV2Create(thclient.ServiceClient(), lbFlavorV2CreateOpts{
+		Name:            "flavor_1",
+		FlavorProfileID: "profile",
+		Enabled:         &enabled,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestLBFlavorV2Update(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/lbaas/flavors/flavor", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"flavor": {"enabled": false}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"flavor": {"id": "flavor", "enabled": false}}`)
+	})
+
+	enabled := false
+	err := lbFlavorV2Update(thclient.ServiceClient(), "flavor", lbFlavorV2UpdateOpts{
+		Enabled: &enabled,
+	})
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,80 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// lbFlavorProfileV2 represents an Octavia flavor profile. Gophercloud doesn't
+// support flavor profiles yet.
+type lbFlavorProfileV2 struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	ProviderName string `json:"provider_name"`
+	FlavorData   string `json:"flavor_data"`
+}
+
+// lbFlavorProfileV2CreateOpts represents the attributes used when creating a
+// new Octavia flavor profile.
+type lbFlavorProfileV2CreateOpts struct {
+	Name         string `json:"name"`
+	ProviderName string `json:"provider_name"`
+	FlavorData   string `json:"flavor_data"`
+}
+
+// lbFlavorProfileV2UpdateOpts represents the attributes used when updating an
+// existing Octavia flavor profile.
+type lbFlavorProfileV2UpdateOpts struct {
+	Name         *string `json:"name,omitempty"`
+	ProviderName *string `json:"provider_name,omitempty"`
+	FlavorData   *string `json:"flavor_data,omitempty"`
+}
+
+func lbFlavorProfileV2Create(client *gophercloud.ServiceClient, opts lbFlavorProfileV2CreateOpts) (*lbFlavorProfileV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "flavorprofile")
+	if err != nil {
+		return nil, err
+	}
+
+	var r struct {
+		FlavorProfile lbFlavorProfileV2 `json:"flavorprofile"`
+	}
+	_, err = client.Post(client.ServiceURL("lbaas", "flavorprofiles"), b, &r, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.FlavorProfile, nil
+}
+
+func lbFlavorProfileV2Get(client *gophercloud.ServiceClient, id string) (*lbFlavorProfileV2, error) {
+	var r struct {
+		FlavorProfile lbFlavorProfileV2 `json:"flavorprofile"`
+	}
+	_, err := client.Get(client.ServiceURL("lbaas", "flavorprofiles", id), &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r.FlavorProfile, nil
+}
+
+func lbFlavorProfileV2Update(client *gophercloud.ServiceClient, id string, opts lbFlavorProfileV2UpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "flavorprofile")
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Put(client.ServiceURL("lbaas", "flavorprofiles", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+func lbFlavorProfileV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("lbaas", "flavorprofiles", id), nil)
+
+	return err
+}
@@ -0,0 +1,59 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLBFlavorProfileV2Get(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/lbaas/flavorprofiles/profile", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "flavorprofile": {
+    "id": "profile",
+    "name": "flavorprofile_1",
+    "provider_name": "amphora",
+    "flavor_data": "{\"loadbalancer_topology\": \"SINGLE\"}"
+  }
+}`)
+	})
+
+	expected := &lbFlavorProfileV2{
+		ID:           "profile",
+		Name:         "flavorprofile_1",
+		ProviderName: "amphora",
+		FlavorData:   `{"loadbalancer_topology": "SINGLE"}`,
+	}
+
+	actual, err := lbFlavorProfileV2Get(thclient.ServiceClient(), "profile")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestLBFlavorProfileV2Delete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/lbaas/flavorprofiles/profile", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := lbFlavorProfileV2Delete(thclient.ServiceClient(), "profile")
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,74 @@
+package openstack
+
+import (
+	"bytes"
+	"fmt"
+
+	octaviapools "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func expandLBMembersV2(members *schema.Set) []octaviapools.BatchUpdateMemberOpts {
+	var m []octaviapools.BatchUpdateMemberOpts
+
+	if members != nil {
+		for _, raw := range members.List() {
+			rawMap := raw.(map[string]interface{})
+			name := rawMap["name"].(string)
+			weight := rawMap["weight"].(int)
+			adminStateUp := rawMap["admin_state_up"].(bool)
+
+			member := octaviapools.BatchUpdateMemberOpts{
+				Address:      rawMap["address"].(string),
+				ProtocolPort: rawMap["protocol_port"].(int),
+				Name:         &name,
+				Weight:       &weight,
+				AdminStateUp: &adminStateUp,
+			}
+
+			// Must omit if not set
+			if subnetID := rawMap["subnet_id"].(string); subnetID != "" {
+				member.SubnetID = &subnetID
+			}
+
+			m = append(m, member)
+		}
+	}
+
+	return m
+}
+
+func flattenLBMembersV2(members []pools.Member) []map[string]interface{} {
+	m := make([]map[string]interface{}, len(members))
+
+	for i, member := range members {
+		m[i] = map[string]interface{}{
+			"name":           member.Name,
+			"weight":         member.Weight,
+			"admin_state_up": member.AdminStateUp,
+			"subnet_id":      member.SubnetID,
+			"address":        member.Address,
+			"protocol_port":  member.ProtocolPort,
+			"id":             member.ID,
+		}
+	}
+
+	return m
+}
+
+// lbMembersV2Hash doesn't include the computed ID of a member, so that a
+// member in the configuration matches the member returned by Octavia.
+func lbMembersV2Hash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["address"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["protocol_port"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["weight"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["subnet_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%t-", m["admin_state_up"].(bool)))
+
+	return hashcode.String(buf.String())
+}
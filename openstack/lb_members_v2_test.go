@@ -0,0 +1,99 @@
+package openstack
+
+import (
+	"testing"
+
+	octaviapools "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandLBMembersV2(t *testing.T) {
+	r := resourceMembersV2()
+	d := r.TestResourceData()
+	d.SetId("1")
+
+	members := []map[string]interface{}{
+		{
+			"address":        "10.0.0.10",
+			"protocol_port":  80,
+			"name":           "member_1",
+			"weight":         5,
+			"subnet_id":      "subnet",
+			"admin_state_up": true,
+		},
+	}
+	d.Set("member", members)
+
+	name := "member_1"
+	weight := 5
+	subnetID := "subnet"
+	adminStateUp := true
+	expected := []octaviapools.BatchUpdateMemberOpts{
+		{
+			Address:      "10.0.0.10",
+			ProtocolPort: 80,
+			Name:         &name,
+			Weight:       &weight,
+			SubnetID:     &subnetID,
+			AdminStateUp: &adminStateUp,
+		},
+	}
+
+	actual := expandLBMembersV2(d.Get("member").(*schema.Set))
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestExpandLBMembersV2NoSubnet(t *testing.T) {
+	r := resourceMembersV2()
+	d := r.TestResourceData()
+	d.SetId("1")
+
+	members := []map[string]interface{}{
+		{
+			"address":        "10.0.0.10",
+			"protocol_port":  80,
+			"weight":         1,
+			"admin_state_up": false,
+		},
+	}
+	d.Set("member", members)
+
+	actual := expandLBMembersV2(d.Get("member").(*schema.Set))
+
+	assert.Len(t, actual, 1)
+	assert.Nil(t, actual[0].SubnetID)
+	assert.Equal(t, false, *actual[0].AdminStateUp)
+}
+
+func TestFlattenLBMembersV2(t *testing.T) {
+	members := []pools.Member{
+		{
+			ID:           "member",
+			Name:         "member_1",
+			Weight:       5,
+			AdminStateUp: true,
+			SubnetID:     "subnet",
+			Address:      "10.0.0.10",
+			ProtocolPort: 80,
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"id":             "member",
+			"name":           "member_1",
+			"weight":         5,
+			"admin_state_up": true,
+			"subnet_id":      "subnet",
+			"address":        "10.0.0.10",
+			"protocol_port":  80,
+		},
+	}
+
+	actual := flattenLBMembersV2(members)
+
+	assert.Equal(t, expected, actual)
+}
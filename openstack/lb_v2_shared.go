@@ -3,12 +3,14 @@ package openstack
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/apiversions"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
@@ -551,3 +553,122 @@ func waitForLBV2L7Rule(lbClient *gophercloud.ServiceClient, parentListener *list
 
 	return nil
 }
+
+// loadBalancerV2Extended adds the attributes, which are only returned by
+// Octavia, to the base loadbalancers.LoadBalancer.
+type loadBalancerV2Extended struct {
+	loadbalancers.LoadBalancer
+	VipNetworkID     string `json:"vip_network_id"`
+	VipQosPolicyID   string `json:"vip_qos_policy_id"`
+	AvailabilityZone string `json:"availability_zone"`
+}
+
+// listenerV2Extended adds the attributes, which are only returned by
+// Octavia, to the base listeners.Listener.
+type listenerV2Extended struct {
+	listeners.Listener
+	TimeoutClientData    int               `json:"timeout_client_data"`
+	TimeoutMemberConnect int               `json:"timeout_member_connect"`
+	TimeoutMemberData    int               `json:"timeout_member_data"`
+	TimeoutTCPInspect    int               `json:"timeout_tcp_inspect"`
+	InsertHeaders        map[string]string `json:"insert_headers"`
+	AllowedCIDRs         []string          `json:"allowed_cidrs"`
+}
+
+// lbV2CheckOctaviaAttributes returns an error if any of the given attributes,
+// which are only supported by Octavia, is set while use_octavia is disabled.
+func lbV2CheckOctaviaAttributes(d *schema.ResourceData, config *Config, resourceName string, attributes ...string) error {
+	if config.useOctavia {
+		return nil
+	}
+
+	for _, attribute := range attributes {
+		if _, ok := d.GetOk(attribute); ok {
+			return fmt.Errorf("%s of %s is only supported by Octavia, set use_octavia to true in the provider configuration", attribute, resourceName)
+		}
+	}
+
+	return nil
+}
+
+// lbV2OctaviaVersion returns the highest version supported by the Octavia
+// API, e.g. "2.12".
+func lbV2OctaviaVersion(lbClient *gophercloud.ServiceClient) (string, error) {
+	allPages, err := apiversions.List(lbClient).AllPages()
+	if err != nil {
+		return "", err
+	}
+
+	allVersions, err := apiversions.ExtractAPIVersions(allPages)
+	if err != nil {
+		return "", err
+	}
+
+	var maxVersion string
+	for _, v := range allVersions {
+		version := strings.TrimPrefix(v.ID, "v")
+		if maxVersion == "" {
+			maxVersion = version
+			continue
+		}
+
+		newer, err := compatibleMicroversion("min", maxVersion, version)
+		if err != nil {
+			return "", err
+		}
+		if newer {
+			maxVersion = version
+		}
+	}
+
+	return maxVersion, nil
+}
+
+// lbV2CheckOctaviaVersion returns an error if any of the given attributes is
+// set while the Octavia API doesn't support the minimum version they require.
+// Once the resource has been created, only the attributes which change are
+// checked, since the computed attributes are always set by Read.
+func lbV2CheckOctaviaVersion(d *schema.ResourceData, lbClient *gophercloud.ServiceClient, resourceName, minVersion string, attributes ...string) error {
+	var attribute string
+	for _, v := range attributes {
+		if d.Id() != "" && !d.HasChange(v) {
+			continue
+		}
+
+		if _, ok := d.GetOk(v); ok {
+			attribute = v
+			break
+		}
+	}
+
+	if attribute == "" {
+		return nil
+	}
+
+	version, err := lbV2OctaviaVersion(lbClient)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Octavia API versions: %s", err)
+	}
+
+	compatible, err := compatibleMicroversion("min", minVersion, version)
+	if err != nil {
+		return err
+	}
+
+	if !compatible {
+		return fmt.Errorf("%s of %s requires the Octavia API version %s, but the highest supported version is %s",
+			attribute, resourceName, minVersion, version)
+	}
+
+	return nil
+}
+
+// lbV2LoadBalancerFailover triggers a failover of the amphorae of a load
+// balancer. It is only supported by Octavia.
+func lbV2LoadBalancerFailover(lbClient *gophercloud.ServiceClient, id string) error {
+	_, err := lbClient.Put(lbClient.ServiceURL("lbaas", "loadbalancers", id, "failover"), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+
+	return err
+}
@@ -0,0 +1,196 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLBV2LoadBalancerFailover(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/lbaas/loadbalancers/lb/failover", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := lbV2LoadBalancerFailover(thclient.ServiceClient(), "lb")
+
+	assert.NoError(t, err)
+}
+
+func TestListenerV2OctaviaCreateOptsExt(t *testing.T) {
+	timeout := 1000
+	opts := ListenerV2OctaviaCreateOptsExt{
+		CreateOptsBuilder: listeners.CreateOpts{
+			Protocol:       listeners.ProtocolHTTP,
+			ProtocolPort:   80,
+			LoadbalancerID: "lb",
+		},
+		TimeoutClientData: &timeout,
+		InsertHeaders: map[string]string{
+			"X-Forwarded-For": "true",
+		},
+	}
+
+	expected := map[string]interface{}{
+		"listener": map[string]interface{}{
+			"protocol":            "HTTP",
+			"protocol_port":       float64(80),
+			"loadbalancer_id":     "lb",
+			"timeout_client_data": float64(1000),
+			"insert_headers": map[string]interface{}{
+				"X-Forwarded-For": "true",
+			},
+		},
+	}
+
+	actual, err := opts.ToListenerCreateMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestLBV2OctaviaVersion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "versions": [
+    {"id": "v2.0", "status": "SUPPORTED"},
+    {"id": "v2.1", "status": "SUPPORTED"},
+    {"id": "v2.12", "status": "CURRENT"},
+    {"id": "v2.2", "status": "SUPPORTED"}
+  ]
+}`)
+	})
+
+	version, err := lbV2OctaviaVersion(thclient.ServiceClient())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2.12", version)
+}
+
+func TestListenerV2CheckOctaviaVersion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "versions": [
+    {"id": "v2.0", "status": "SUPPORTED"},
+    {"id": "v2.1", "status": "CURRENT"}
+  ]
+}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceListenerV2().Schema, map[string]interface{}{
+		"timeout_client_data": 1000,
+	})
+	assert.NoError(t, listenerV2CheckOctaviaVersion(d, thclient.ServiceClient()))
+
+	d = schema.TestResourceDataRaw(t, resourceListenerV2().Schema, map[string]interface{}{
+		"allowed_cidrs": []interface{}{"192.0.2.0/24"},
+	})
+	assert.EqualError(t, listenerV2CheckOctaviaVersion(d, thclient.ServiceClient()),
+		"allowed_cidrs of openstack_lb_listener_v2 requires the Octavia API version 2.12, but the highest supported version is 2.1")
+}
+
+func TestListenerV2CheckOctaviaVersionUnchanged(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request of the Octavia API versions")
+	})
+
+	// The computed timeouts are set by Read, but don't change.
+	d := resourceListenerV2().Data(&terraform.InstanceState{
+		ID: "listener",
+		Attributes: map[string]string{
+			"timeout_client_data": "50000",
+			"allowed_cidrs.#":     "1",
+			"allowed_cidrs.0":     "192.0.2.0/24",
+		},
+	})
+	assert.NoError(t, listenerV2CheckOctaviaVersion(d, thclient.ServiceClient()))
+}
+
+func TestLoadBalancerV2CheckOctaviaVersionFailover(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "versions": [
+    {"id": "v2.0", "status": "CURRENT"}
+  ]
+}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceLoadBalancerV2().Schema, map[string]interface{}{
+		"failover": "1",
+	})
+	d.SetId("lb")
+
+	assert.EqualError(t, lbV2CheckOctaviaVersion(d, thclient.ServiceClient(), "openstack_lb_loadbalancer_v2", "2.1", "failover"),
+		"failover of openstack_lb_loadbalancer_v2 requires the Octavia API version 2.1, but the highest supported version is 2.0")
+}
+
+func TestLoadBalancerV2CustomizeDiff(t *testing.T) {
+	diff := func(raw map[string]interface{}, useOctavia bool) error {
+		rawConfig, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		_, err = resourceLoadBalancerV2().Diff(nil, terraform.NewResourceConfig(rawConfig), &Config{
+			useOctavia: useOctavia,
+		})
+		return err
+	}
+
+	assert.NoError(t, diff(map[string]interface{}{
+		"vip_subnet_id": "subnet",
+	}, false))
+
+	assert.Error(t, diff(map[string]interface{}{
+		"vip_network_id": "network",
+	}, false))
+
+	assert.NoError(t, diff(map[string]interface{}{
+		"vip_network_id": "network",
+	}, true))
+
+	// The VIP subnet isn't known yet, when it is interpolated from another
+	// resource.
+	assert.NoError(t, diff(map[string]interface{}{
+		"vip_subnet_id": "${openstack_networking_subnet_v2.subnet_1.id}",
+	}, false))
+}
@@ -6,12 +6,14 @@ import (
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/dns"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
 	"github.com/hashicorp/terraform/helper/resource"
 )
 
 type floatingIPExtended struct {
 	floatingips.FloatingIP
 	dns.FloatingIPDNSExt
+	policies.QoSPolicyExt
 }
 
 // networkingFloatingIPV2ID retrieves floating IP ID by the provided IP address.
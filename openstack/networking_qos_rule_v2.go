@@ -0,0 +1,71 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/rules"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// resourceNetworkingQoSRuleV2BuildID builds the ID of a QoS rule resource.
+// A rule can only be retrieved by using the ID of its QoS policy, so both
+// of them are stored in the resource ID.
+func resourceNetworkingQoSRuleV2BuildID(qosPolicyID, qosRuleID string) string {
+	return fmt.Sprintf("%s/%s", qosPolicyID, qosRuleID)
+}
+
+func resourceNetworkingQoSRuleV2ParseID(qosRuleID string) (string, string, error) {
+	qosRuleIDParts := strings.Split(qosRuleID, "/")
+	if len(qosRuleIDParts) != 2 || qosRuleIDParts[0] == "" || qosRuleIDParts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format: %s, expected <qos_policy_id>/<rule_id>", qosRuleID)
+	}
+
+	return qosRuleIDParts[0], qosRuleIDParts[1], nil
+}
+
+func networkingQoSBandwidthLimitRuleV2StateRefreshFunc(client *gophercloud.ServiceClient, policyID, ruleID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := rules.GetBandwidthLimitRule(client, policyID, ruleID).ExtractBandwidthLimitRule()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return rule, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		return rule, "ACTIVE", nil
+	}
+}
+
+func networkingQoSDSCPMarkingRuleV2StateRefreshFunc(client *gophercloud.ServiceClient, policyID, ruleID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := rules.GetDSCPMarkingRule(client, policyID, ruleID).ExtractDSCPMarkingRule()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return rule, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		return rule, "ACTIVE", nil
+	}
+}
+
+func networkingQoSMinimumBandwidthRuleV2StateRefreshFunc(client *gophercloud.ServiceClient, policyID, ruleID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := rules.GetMinimumBandwidthRule(client, policyID, ruleID).ExtractMinimumBandwidthRule()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return rule, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		return rule, "ACTIVE", nil
+	}
+}
@@ -0,0 +1,26 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceNetworkingQoSRuleV2BuildID(t *testing.T) {
+	assert.Equal(t, "policy/rule", resourceNetworkingQoSRuleV2BuildID("policy", "rule"))
+}
+
+func TestResourceNetworkingQoSRuleV2ParseID(t *testing.T) {
+	qosPolicyID, qosRuleID, err := resourceNetworkingQoSRuleV2ParseID("policy/rule")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "policy", qosPolicyID)
+	assert.Equal(t, "rule", qosRuleID)
+}
+
+func TestResourceNetworkingQoSRuleV2ParseIDInvalid(t *testing.T) {
+	for _, id := range []string{"", "rule", "policy/", "/rule", "policy/rule/extra"} {
+		_, _, err := resourceNetworkingQoSRuleV2ParseID(id)
+		assert.Error(t, err, id)
+	}
+}
@@ -0,0 +1,77 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// networkingQuotaV2Resources are the Neutron quotas managed by
+// openstack_networking_quota_v2.
+var networkingQuotaV2Resources = []string{
+	"floatingip",
+	"network",
+	"port",
+	"rbac_policy",
+	"router",
+	"security_group",
+	"security_group_rule",
+	"subnet",
+	"subnetpool",
+}
+
+// networkingQuotaV2Detail represents the usage of a single Neutron quota, as
+// returned by the quota details API.
+type networkingQuotaV2Detail struct {
+	Used     int `json:"used"`
+	Reserved int `json:"reserved"`
+	Limit    int `json:"limit"`
+}
+
+// networkingQuotaV2GetDetails retrieves the limits and the usage of the
+// quotas of a project. Gophercloud doesn't support this call yet.
+func networkingQuotaV2GetDetails(client *gophercloud.ServiceClient, projectID string) (map[string]networkingQuotaV2Detail, error) {
+	var r struct {
+		Quota map[string]networkingQuotaV2Detail `json:"quota"`
+	}
+
+	_, err := client.Get(client.ServiceURL("quotas", projectID, "details"), &r, nil)
+
+	return r.Quota, err
+}
+
+// networkingQuotaV2Delete resets the quotas of a project to the defaults.
+// Gophercloud doesn't support this call yet.
+func networkingQuotaV2Delete(client *gophercloud.ServiceClient, projectID string) error {
+	_, err := client.Delete(client.ServiceURL("quotas", projectID), nil)
+
+	return err
+}
+
+func expandNetworkingQuotaV2UpdateOpts(d *schema.ResourceData) quotas.UpdateOpts {
+	return quotas.UpdateOpts{
+		FloatingIP:        expandQuotaValue(d, "floatingip"),
+		Network:           expandQuotaValue(d, "network"),
+		Port:              expandQuotaValue(d, "port"),
+		RBACPolicy:        expandQuotaValue(d, "rbac_policy"),
+		Router:            expandQuotaValue(d, "router"),
+		SecurityGroup:     expandQuotaValue(d, "security_group"),
+		SecurityGroupRule: expandQuotaValue(d, "security_group_rule"),
+		Subnet:            expandQuotaValue(d, "subnet"),
+		SubnetPool:        expandQuotaValue(d, "subnetpool"),
+	}
+}
+
+func flattenNetworkingQuotaV2(q *quotas.Quota) map[string]int {
+	return map[string]int{
+		"floatingip":          q.FloatingIP,
+		"network":             q.Network,
+		"port":                q.Port,
+		"rbac_policy":         q.RBACPolicy,
+		"router":              q.Router,
+		"security_group":      q.SecurityGroup,
+		"security_group_rule": q.SecurityGroupRule,
+		"subnet":              q.Subnet,
+		"subnetpool":          q.SubnetPool,
+	}
+}
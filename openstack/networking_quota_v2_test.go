@@ -0,0 +1,55 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkingQuotaV2GetDetails(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/quotas/project/details", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "quota": {
+    "network": {"used": 1, "limit": 10, "reserved": 0},
+    "port": {"used": 5, "limit": 50, "reserved": 2}
+  }
+}`)
+	})
+
+	expected := map[string]networkingQuotaV2Detail{
+		"network": {Used: 1, Limit: 10, Reserved: 0},
+		"port":    {Used: 5, Limit: 50, Reserved: 2},
+	}
+
+	actual, err := networkingQuotaV2GetDetails(thclient.ServiceClient(), "project")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestNetworkingQuotaV2Delete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/quotas/project", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := networkingQuotaV2Delete(thclient.ServiceClient(), "project")
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,78 @@
+package openstack
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// The temp URL keys of an account are stored by Swift as account metadata.
+// They are managed with dedicated attributes, so these keys are not part of
+// the metadata attribute. The account quota can only be set by a reseller
+// admin.
+const (
+	objectStorageAccountV1TempURLKey  = "Temp-Url-Key"
+	objectStorageAccountV1TempURLKey2 = "Temp-Url-Key-2"
+	objectStorageAccountV1QuotaBytes  = "Quota-Bytes"
+)
+
+var objectStorageAccountV1ReservedMetadata = []string{
+	objectStorageAccountV1TempURLKey,
+	objectStorageAccountV1TempURLKey2,
+	objectStorageAccountV1QuotaBytes,
+}
+
+func objectStorageAccountV1IsReservedMetadata(key string) bool {
+	for _, reserved := range objectStorageAccountV1ReservedMetadata {
+		if strings.EqualFold(key, reserved) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateObjectStorageAccountV1Metadata rejects the metadata keys which
+// are managed with dedicated attributes.
+func validateObjectStorageAccountV1Metadata(v interface{}, k string) (ws []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if objectStorageAccountV1IsReservedMetadata(key) {
+			errors = append(errors, fmt.Errorf("%q cannot contain %q", k, key))
+		}
+	}
+
+	return
+}
+
+// objectStorageAccountV1Metadata returns the account metadata without the
+// reserved keys.
+func objectStorageAccountV1Metadata(metadata map[string]string) map[string]string {
+	m := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		if !objectStorageAccountV1IsReservedMetadata(k) {
+			m[k] = v
+		}
+	}
+
+	return m
+}
+
+// objectStorageAccountV1ID returns the name of the account the object
+// storage client is scoped to, which is the last part of the endpoint,
+// e.g. AUTH_<project_id>.
+func objectStorageAccountV1ID(client *gophercloud.ServiceClient) (string, error) {
+	u, err := url.Parse(client.ResourceBaseURL())
+	if err != nil {
+		return "", fmt.Errorf("Unable to parse the object storage endpoint %s: %s", client.ResourceBaseURL(), err)
+	}
+
+	account := path.Base(strings.TrimSuffix(u.Path, "/"))
+	if account == "" || account == "." || account == "/" {
+		return "", fmt.Errorf("Unable to determine the account from the object storage endpoint %s", client.ResourceBaseURL())
+	}
+
+	return account, nil
+}
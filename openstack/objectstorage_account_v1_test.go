@@ -0,0 +1,68 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStorageAccountV1ID(t *testing.T) {
+	client := &gophercloud.ServiceClient{
+		Endpoint: "https://swift.example.com:8080/v1/AUTH_0123456789abcdef/",
+	}
+
+	account, err := objectStorageAccountV1ID(client)
+	assert.NoError(t, err)
+	assert.Equal(t, "AUTH_0123456789abcdef", account)
+
+	client.Endpoint = "https://swift.example.com/"
+	_, err = objectStorageAccountV1ID(client)
+	assert.Error(t, err)
+}
+
+func TestObjectStorageAccountV1Metadata(t *testing.T) {
+	metadata := map[string]string{
+		"Foo":            "bar",
+		"Temp-Url-Key":   "secret",
+		"Temp-Url-Key-2": "secret2",
+		"Quota-Bytes":    "1024",
+	}
+
+	assert.Equal(t, map[string]string{"Foo": "bar"}, objectStorageAccountV1Metadata(metadata))
+}
+
+func TestValidateObjectStorageAccountV1Metadata(t *testing.T) {
+	_, errs := validateObjectStorageAccountV1Metadata(map[string]interface{}{
+		"foo": "bar",
+	}, "metadata")
+	assert.Empty(t, errs)
+
+	_, errs = validateObjectStorageAccountV1Metadata(map[string]interface{}{
+		"temp-url-key": "secret",
+	}, "metadata")
+	assert.Len(t, errs, 1)
+}
+
+func TestAccountUpdateOpts(t *testing.T) {
+	opts := AccountUpdateOpts{
+		UpdateOptsBuilder: accounts.UpdateOpts{
+			TempURLKey: "secret",
+			Metadata: map[string]string{
+				"foo": "bar",
+			},
+		},
+		RemoveMetadata: []string{"baz", objectStorageAccountV1TempURLKey2},
+	}
+
+	headers, err := opts.ToAccountUpdateMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"X-Account-Meta-Temp-URL-Key":          "secret",
+		"X-Account-Meta-foo":                   "bar",
+		"X-Remove-Account-Meta-baz":            "remove",
+		"X-Remove-Account-Meta-Temp-Url-Key-2": "remove",
+	}, headers)
+}
@@ -0,0 +1,153 @@
+package openstack
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Container quotas and CORS settings are stored by Swift as container
+// metadata. They are managed with dedicated attributes, so these keys
+// are not part of the metadata attribute.
+const (
+	objectStorageContainerV1QuotaBytes        = "Quota-Bytes"
+	objectStorageContainerV1QuotaCount        = "Quota-Count"
+	objectStorageContainerV1CORSAllowOrigin   = "Access-Control-Allow-Origin"
+	objectStorageContainerV1CORSExposeHeaders = "Access-Control-Expose-Headers"
+	objectStorageContainerV1CORSMaxAge        = "Access-Control-Max-Age"
+)
+
+var objectStorageContainerV1ReservedMetadata = []string{
+	objectStorageContainerV1QuotaBytes,
+	objectStorageContainerV1QuotaCount,
+	objectStorageContainerV1CORSAllowOrigin,
+	objectStorageContainerV1CORSExposeHeaders,
+	objectStorageContainerV1CORSMaxAge,
+}
+
+func objectStorageContainerV1IsReservedMetadata(key string) bool {
+	for _, reserved := range objectStorageContainerV1ReservedMetadata {
+		if strings.EqualFold(key, reserved) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateObjectStorageContainerV1Metadata rejects the metadata keys which
+// are managed with dedicated attributes.
+func validateObjectStorageContainerV1Metadata(v interface{}, k string) (ws []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if objectStorageContainerV1IsReservedMetadata(key) {
+			errors = append(errors, fmt.Errorf("%q cannot contain %q, use the dedicated attribute instead", k, key))
+		}
+	}
+
+	return
+}
+
+// objectStorageContainerV1TypedMetadata returns the container metadata
+// built from the quota and CORS attributes, and the keys of the attributes
+// which are unset and need to be removed.
+func objectStorageContainerV1TypedMetadata(d *schema.ResourceData) (map[string]string, []string) {
+	values := map[string]string{}
+
+	if v, ok := d.GetOk("quota_bytes"); ok {
+		values[objectStorageContainerV1QuotaBytes] = strconv.Itoa(v.(int))
+	}
+
+	if v, ok := d.GetOk("quota_count"); ok {
+		values[objectStorageContainerV1QuotaCount] = strconv.Itoa(v.(int))
+	}
+
+	if v, ok := d.GetOk("cors"); ok {
+		cors := v.([]interface{})[0].(map[string]interface{})
+
+		if origins := expandToStringSlice(cors["allow_origins"].([]interface{})); len(origins) > 0 {
+			values[objectStorageContainerV1CORSAllowOrigin] = strings.Join(origins, " ")
+		}
+
+		if headers := expandToStringSlice(cors["expose_headers"].([]interface{})); len(headers) > 0 {
+			values[objectStorageContainerV1CORSExposeHeaders] = strings.Join(headers, " ")
+		}
+
+		if maxAge := cors["max_age"].(int); maxAge > 0 {
+			values[objectStorageContainerV1CORSMaxAge] = strconv.Itoa(maxAge)
+		}
+	}
+
+	var removed []string
+	for _, key := range objectStorageContainerV1ReservedMetadata {
+		if _, ok := values[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	return values, removed
+}
+
+// objectStorageContainerV1SetTypedMetadata sets the quota and CORS
+// attributes from the container metadata, and returns the remaining
+// metadata.
+func objectStorageContainerV1SetTypedMetadata(d *schema.ResourceData, metadata map[string]string) (map[string]string, error) {
+	values := map[string]string{}
+	remaining := map[string]string{}
+	for k, v := range metadata {
+		if objectStorageContainerV1IsReservedMetadata(k) {
+			values[strings.ToLower(k)] = v
+			continue
+		}
+		remaining[k] = v
+	}
+
+	quotaBytes, err := objectStorageContainerV1ParseInt(values, objectStorageContainerV1QuotaBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	quotaCount, err := objectStorageContainerV1ParseInt(values, objectStorageContainerV1QuotaCount)
+	if err != nil {
+		return nil, err
+	}
+
+	maxAge, err := objectStorageContainerV1ParseInt(values, objectStorageContainerV1CORSMaxAge)
+	if err != nil {
+		return nil, err
+	}
+
+	var cors []map[string]interface{}
+	origins := strings.Fields(values[strings.ToLower(objectStorageContainerV1CORSAllowOrigin)])
+	headers := strings.Fields(values[strings.ToLower(objectStorageContainerV1CORSExposeHeaders)])
+	if len(origins) > 0 || len(headers) > 0 || maxAge > 0 {
+		cors = append(cors, map[string]interface{}{
+			"allow_origins":  origins,
+			"expose_headers": headers,
+			"max_age":        maxAge,
+		})
+	}
+
+	d.Set("quota_bytes", quotaBytes)
+	d.Set("quota_count", quotaCount)
+	if err := d.Set("cors", cors); err != nil {
+		return nil, fmt.Errorf("Unable to set cors: %s", err)
+	}
+
+	return remaining, nil
+}
+
+func objectStorageContainerV1ParseInt(values map[string]string, key string) (int, error) {
+	v, ok := values[strings.ToLower(key)]
+	if !ok || v == "" {
+		return 0, nil
+	}
+
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to parse container metadata %s=%q: %s", key, v, err)
+	}
+
+	return i, nil
+}
@@ -0,0 +1,95 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStorageContainerV1TypedMetadata(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceObjectStorageContainerV1().Schema, map[string]interface{}{
+		"name":        "container_1",
+		"quota_bytes": 1024,
+		"cors": []interface{}{
+			map[string]interface{}{
+				"allow_origins":  []interface{}{"https://example.com", "https://example.org"},
+				"expose_headers": []interface{}{"X-Foo"},
+			},
+		},
+	})
+
+	values, removed := objectStorageContainerV1TypedMetadata(d)
+
+	assert.Equal(t, map[string]string{
+		"Quota-Bytes":                   "1024",
+		"Access-Control-Allow-Origin":   "https://example.com https://example.org",
+		"Access-Control-Expose-Headers": "X-Foo",
+	}, values)
+	assert.Equal(t, []string{"Quota-Count", "Access-Control-Max-Age"}, removed)
+}
+
+func TestObjectStorageContainerV1SetTypedMetadata(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceObjectStorageContainerV1().Schema, map[string]interface{}{})
+
+	metadata := map[string]string{
+		"Test":                        "true",
+		"Quota-Count":                 "10",
+		"Access-Control-Allow-Origin": "https://example.com  https://example.org",
+		"Access-Control-Max-Age":      "3600",
+	}
+
+	remaining, err := objectStorageContainerV1SetTypedMetadata(d, metadata)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"Test": "true"}, remaining)
+	assert.Equal(t, 0, d.Get("quota_bytes"))
+	assert.Equal(t, 10, d.Get("quota_count"))
+	assert.Equal(t, []interface{}{"https://example.com", "https://example.org"}, d.Get("cors.0.allow_origins"))
+	assert.Equal(t, 0, d.Get("cors.0.expose_headers.#"))
+	assert.Equal(t, 3600, d.Get("cors.0.max_age"))
+}
+
+func TestObjectStorageContainerV1SetTypedMetadataInvalid(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceObjectStorageContainerV1().Schema, map[string]interface{}{})
+
+	_, err := objectStorageContainerV1SetTypedMetadata(d, map[string]string{
+		"Quota-Bytes": "foo",
+	})
+
+	assert.Error(t, err)
+}
+
+func TestValidateObjectStorageContainerV1Metadata(t *testing.T) {
+	_, errs := validateObjectStorageContainerV1Metadata(map[string]interface{}{
+		"test": "true",
+	}, "metadata")
+	assert.Empty(t, errs)
+
+	_, errs = validateObjectStorageContainerV1Metadata(map[string]interface{}{
+		"quota-bytes": "1024",
+	}, "metadata")
+	assert.Len(t, errs, 1)
+}
+
+func TestContainerCreateOpts(t *testing.T) {
+	opts := ContainerCreateOpts{
+		CreateOptsBuilder: containers.CreateOpts{
+			ContainerRead: ".r:*",
+			Metadata: map[string]string{
+				"Quota-Bytes": "1024",
+			},
+		},
+		StoragePolicy: "gold",
+	}
+
+	headers, err := opts.ToContainerCreateMap()
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"X-Container-Read":             ".r:*",
+		"X-Container-Meta-Quota-Bytes": "1024",
+		"X-Storage-Policy":             "gold",
+	}, headers)
+}
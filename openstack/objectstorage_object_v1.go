@@ -0,0 +1,365 @@
+package openstack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// objectStorageObjectV1MinSegmentSize is the default minimum size of a
+// segment of a Static Large Object, except for the last one.
+const objectStorageObjectV1MinSegmentSize = 1024 * 1024
+
+// objectStorageObjectV1DefaultSegmentSize is the size of the segments of
+// sources which are too large to be uploaded in a single request, when no
+// segment_size is set.
+const objectStorageObjectV1DefaultSegmentSize int64 = 1024 * 1024 * 1024
+
+// objectStorageObjectV1MaxObjectSize is the default maximum size of an
+// object uploaded in a single request, and so of a segment.
+const objectStorageObjectV1MaxObjectSize int64 = 5 * 1024 * 1024 * 1024
+
+// objectStorageObjectV1DefaultSegmentConcurrency is the default number of
+// segments uploaded in parallel.
+const objectStorageObjectV1DefaultSegmentConcurrency = 4
+
+// objectStorageObjectV1MaxSegments is the default maximum number of
+// segments referenced by a Static Large Object manifest.
+const objectStorageObjectV1MaxSegments = 1000
+
+// objectStorageObjectV1Segment is a segment of a Static Large Object,
+// as sent to Swift when creating the manifest.
+type objectStorageObjectV1Segment struct {
+	Path      string `json:"path"`
+	ETag      string `json:"etag"`
+	SizeBytes int64  `json:"size_bytes"`
+}
+
+// objectStorageObjectV1ManifestSegment is a segment of a Static Large Object,
+// as returned by Swift when retrieving the manifest.
+type objectStorageObjectV1ManifestSegment struct {
+	Name  string `json:"name"`
+	Hash  string `json:"hash"`
+	Bytes int64  `json:"bytes"`
+}
+
+// objectStorageObjectV1SegmentContainer returns the container to upload the
+// segments of the object to. It defaults to "<container_name>_segments",
+// like the swift command line client.
+func objectStorageObjectV1SegmentContainer(d *schema.ResourceData) string {
+	if v := d.Get("segment_container").(string); v != "" {
+		return v
+	}
+
+	return fmt.Sprintf("%s_segments", d.Get("container_name").(string))
+}
+
+// objectStorageObjectV1SegmentSize returns the size of the segments to
+// upload an object source of the given size in, or 0 if it is uploaded in a
+// single request. Sources larger than the maximum object size are always
+// segmented, in segments of the configured size or of a size which keeps the
+// number of segments within the manifest limit. An error is returned when
+// the source cannot be uploaded, before anything is uploaded.
+func objectStorageObjectV1SegmentSize(segmentSize, size int64) (int64, error) {
+	if segmentSize == 0 {
+		if size <= objectStorageObjectV1MaxObjectSize {
+			return 0, nil
+		}
+
+		segmentSize = objectStorageObjectV1DefaultSegmentSize
+		if minSize := (size + objectStorageObjectV1MaxSegments - 1) / objectStorageObjectV1MaxSegments; minSize > segmentSize {
+			segmentSize = (minSize + objectStorageObjectV1MinSegmentSize - 1) / objectStorageObjectV1MinSegmentSize * objectStorageObjectV1MinSegmentSize
+		}
+	}
+
+	if size <= segmentSize && size <= objectStorageObjectV1MaxObjectSize {
+		return 0, nil
+	}
+
+	if segmentSize > objectStorageObjectV1MaxObjectSize {
+		return 0, fmt.Errorf("The segment size of %d bytes is larger than the maximum object size of %d bytes", segmentSize, objectStorageObjectV1MaxObjectSize)
+	}
+
+	count := (size + segmentSize - 1) / segmentSize
+	if count > objectStorageObjectV1MaxSegments {
+		return 0, fmt.Errorf("Uploading %d bytes in segments of %d bytes needs %d segments, more than the maximum of %d: increase segment_size", size, segmentSize, count, objectStorageObjectV1MaxSegments)
+	}
+
+	return segmentSize, nil
+}
+
+// objectStorageObjectV1HasChange returns whether the object needs to be
+// written again. The segment attributes only control how the source is
+// uploaded, and writing the object without a changed content would replace
+// it with an empty one.
+func objectStorageObjectV1HasChange(d *schema.ResourceData) bool {
+	for _, key := range []string{
+		"content_disposition", "content_encoding", "content_type", "content",
+		"copy_from", "delete_after", "delete_at", "detect_content_type",
+		"etag", "metadata", "object_manifest", "source",
+	} {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// objectStorageObjectV1SegmentPrefix returns a unique prefix for the
+// segments of an object. A new prefix is used on every upload, so that the
+// segments of the current manifest are kept until the new one is written.
+func objectStorageObjectV1SegmentPrefix(name string, size, segmentSize int64) string {
+	return fmt.Sprintf("%s/slo/%d/%d/%d", name, time.Now().UnixNano(), size, segmentSize)
+}
+
+// objectStorageObjectV1UploadSegments uploads the source in segments of
+// segmentSize bytes, with up to concurrency parallel uploads. Already
+// uploaded segments are removed when one of the uploads fails.
+func objectStorageObjectV1UploadSegments(client *gophercloud.ServiceClient, container, prefix string, source io.ReaderAt, size, segmentSize int64, concurrency int) ([]objectStorageObjectV1Segment, error) {
+	// Make sure the segment container exists.
+	if err := containers.Create(client, container, nil).Err; err != nil {
+		return nil, fmt.Errorf("Error creating segment container %s: %s", container, err)
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	count := int((size + segmentSize - 1) / segmentSize)
+	segments := make([]objectStorageObjectV1Segment, count)
+	errs := make([]error, count)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+
+	for i := 0; i < count; i++ {
+		offset := int64(i) * segmentSize
+		length := segmentSize
+		if offset+length > size {
+			length = size - offset
+		}
+		name := fmt.Sprintf("%s/%08d", prefix, i)
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, name string, offset, length int64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			createOpts := &objects.CreateOpts{
+				Content:       io.NewSectionReader(source, offset, length),
+				ContentLength: length,
+			}
+
+			log.Printf("[DEBUG] Uploading segment %s/%s (%d bytes)", container, name, length)
+			header, err := objects.Create(client, container, name, createOpts).Extract()
+			if err != nil {
+				errs[i] = fmt.Errorf("Error uploading segment %s/%s: %s", container, name, err)
+				return
+			}
+
+			segments[i] = objectStorageObjectV1Segment{
+				Path:      fmt.Sprintf("/%s/%s", container, name),
+				ETag:      header.ETag,
+				SizeBytes: length,
+			}
+		}(i, name, offset, length)
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		var uploaded []objectStorageObjectV1Segment
+		for _, segment := range segments {
+			if segment.Path != "" {
+				uploaded = append(uploaded, segment)
+			}
+		}
+
+		if cleanupErr := objectStorageObjectV1DeleteSegments(client, uploaded); cleanupErr != nil {
+			log.Printf("[WARN] %s", cleanupErr)
+		}
+
+		return nil, err
+	}
+
+	return segments, nil
+}
+
+// objectStorageObjectV1GetSegments returns the segments of an object if it
+// is a Static Large Object, and nil otherwise.
+func objectStorageObjectV1GetSegments(client *gophercloud.ServiceClient, container, name string) ([]objectStorageObjectV1Segment, error) {
+	header, err := objects.Get(client, container, name, nil).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error getting OpenStack container object: %s", err)
+	}
+
+	if !header.StaticLargeObject {
+		return nil, nil
+	}
+
+	downloadOpts := objects.DownloadOpts{
+		MultipartManifest: "get",
+	}
+	result := objects.Download(client, container, name, downloadOpts)
+	content, err := result.ExtractContent()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting manifest of OpenStack container object %s/%s: %s", container, name, err)
+	}
+
+	var manifest []objectStorageObjectV1ManifestSegment
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, fmt.Errorf("Error parsing manifest of OpenStack container object %s/%s: %s", container, name, err)
+	}
+
+	segments := make([]objectStorageObjectV1Segment, len(manifest))
+	for i, v := range manifest {
+		segments[i] = objectStorageObjectV1Segment{
+			Path:      v.Name,
+			ETag:      v.Hash,
+			SizeBytes: v.Bytes,
+		}
+	}
+
+	return segments, nil
+}
+
+// objectStorageObjectV1DeleteSegments deletes the given segments.
+// Segments which do not exist anymore are ignored.
+func objectStorageObjectV1DeleteSegments(client *gophercloud.ServiceClient, segments []objectStorageObjectV1Segment) error {
+	for _, segment := range segments {
+		parts := strings.SplitN(strings.TrimPrefix(segment.Path, "/"), "/", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("Unable to determine container and object name of segment %s", segment.Path)
+		}
+
+		log.Printf("[DEBUG] Deleting segment %s", segment.Path)
+		_, err := objects.Delete(client, parts[0], parts[1], nil).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("Error deleting segment %s: %s", segment.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// objectStorageObjectV1Delete deletes an object. The segments of a Static
+// Large Object are deleted along with its manifest: Swift then answers with
+// 200 and reports the deletion of the segments in the response body, which
+// objects.Delete doesn't accept.
+func objectStorageObjectV1Delete(client *gophercloud.ServiceClient, container, name string) error {
+	deleteOpts := objects.DeleteOpts{
+		MultipartManifest: "delete",
+	}
+
+	query, err := deleteOpts.ToObjectDeleteQuery()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Request("DELETE", client.ServiceURL(container, name)+query, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{"Accept": "application/json"},
+		OkCodes:     []int{200, 202, 204},
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	var r struct {
+		ResponseStatus string     `json:"Response Status"`
+		Errors         [][]string `json:"Errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return fmt.Errorf("Error parsing the deletion result of %s/%s: %s", container, name, err)
+	}
+
+	if r.ResponseStatus != "" && !strings.HasPrefix(r.ResponseStatus, "2") {
+		return fmt.Errorf("Error deleting the segments of %s/%s: %s %v", container, name, r.ResponseStatus, r.Errors)
+	}
+
+	return nil
+}
+
+// objectStorageObjectV1ManifestOpts turns createOpts into the creation of a
+// Static Large Object manifest referencing the given segments.
+func objectStorageObjectV1ManifestOpts(createOpts *objects.CreateOpts, segments []objectStorageObjectV1Segment) error {
+	b, err := json.Marshal(segments)
+	if err != nil {
+		return fmt.Errorf("Error building manifest: %s", err)
+	}
+
+	createOpts.Content = bytes.NewReader(b)
+	createOpts.ContentLength = int64(len(b))
+	createOpts.MultipartManifest = "put"
+	createOpts.TransferEncoding = ""
+	createOpts.CopyFrom = ""
+	createOpts.ObjectManifest = ""
+
+	// The ETag of a manifest is computed from the ETags of the segments,
+	// not from the manifest itself.
+	createOpts.NoETag = true
+	createOpts.ETag = ""
+
+	return nil
+}
+
+// objectStorageObjectV1ParseID returns the container and the object name
+// of an object ID in the "container/object" form. The object name may
+// contain slashes.
+func objectStorageObjectV1ParseID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine object ID from %s, expected <container>/<object>", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+// objectStorageV1Metadata converts the custom metadata of a container or an
+// object into its configuration form. Swift header names are
+// case-insensitive and are canonicalized by the HTTP client, so the keys
+// are lowercased, unless a key of the configured metadata matches them.
+func objectStorageV1Metadata(metadata map[string]string, configured map[string]interface{}) map[string]string {
+	keys := make(map[string]string, len(configured))
+	for k := range configured {
+		keys[strings.ToLower(k)] = k
+	}
+
+	m := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		key := strings.ToLower(k)
+		if configuredKey, ok := keys[key]; ok {
+			key = configuredKey
+		}
+		m[key] = v
+	}
+
+	return m
+}
@@ -0,0 +1,243 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectStorageObjectV1UploadSegments(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/segments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	var mu sync.Mutex
+	uploaded := map[string]string{}
+	th.Mux.HandleFunc("/segments/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		mu.Lock()
+		uploaded[r.URL.Path] = string(b)
+		mu.Unlock()
+
+		w.Header().Add("ETag", fmt.Sprintf("etag-%d", len(b)))
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	source := strings.NewReader("aaaabbbbcc")
+	segments, err := objectStorageObjectV1UploadSegments(thclient.ServiceClient(), "segments", "object/slo/1", source, 10, 4, 2)
+
+	expected := []objectStorageObjectV1Segment{
+		{Path: "/segments/object/slo/1/00000000", ETag: "etag-4", SizeBytes: 4},
+		{Path: "/segments/object/slo/1/00000001", ETag: "etag-4", SizeBytes: 4},
+		{Path: "/segments/object/slo/1/00000002", ETag: "etag-2", SizeBytes: 2},
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, segments)
+	assert.Equal(t, map[string]string{
+		"/segments/object/slo/1/00000000": "aaaa",
+		"/segments/object/slo/1/00000001": "bbbb",
+		"/segments/object/slo/1/00000002": "cc",
+	}, uploaded)
+}
+
+func TestObjectStorageObjectV1GetSegments(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/container/object", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "HEAD":
+			w.Header().Add("X-Static-Large-Object", "True")
+			w.WriteHeader(http.StatusOK)
+		case "GET":
+			assert.Equal(t, "get", r.URL.Query().Get("multipart-manifest"))
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `
+[
+  {
+    "name": "/segments/object/slo/1/00000000",
+    "hash": "etag-4",
+    "bytes": 4
+  },
+  {
+    "name": "/segments/object/slo/1/00000001",
+    "hash": "etag-2",
+    "bytes": 2
+  }
+]`)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	segments, err := objectStorageObjectV1GetSegments(thclient.ServiceClient(), "container", "object")
+
+	expected := []objectStorageObjectV1Segment{
+		{Path: "/segments/object/slo/1/00000000", ETag: "etag-4", SizeBytes: 4},
+		{Path: "/segments/object/slo/1/00000001", ETag: "etag-2", SizeBytes: 2},
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, segments)
+}
+
+func TestObjectStorageObjectV1GetSegmentsNotSLO(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/container/object", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	segments, err := objectStorageObjectV1GetSegments(thclient.ServiceClient(), "container", "object")
+
+	assert.NoError(t, err)
+	assert.Nil(t, segments)
+}
+
+func TestObjectStorageObjectV1ManifestOpts(t *testing.T) {
+	segments := []objectStorageObjectV1Segment{
+		{Path: "/segments/object/slo/1/00000000", ETag: "etag-4", SizeBytes: 4},
+	}
+
+	createOpts := &objects.CreateOpts{
+		ETag:             "foo",
+		TransferEncoding: "chunked",
+	}
+
+	err := objectStorageObjectV1ManifestOpts(createOpts, segments)
+	assert.NoError(t, err)
+
+	b, err := ioutil.ReadAll(createOpts.Content)
+	assert.NoError(t, err)
+
+	var actual []objectStorageObjectV1Segment
+	assert.NoError(t, json.Unmarshal(b, &actual))
+	assert.Equal(t, segments, actual)
+	assert.Equal(t, int64(len(b)), createOpts.ContentLength)
+	assert.Equal(t, "put", createOpts.MultipartManifest)
+	assert.Equal(t, "", createOpts.ETag)
+	assert.Equal(t, "", createOpts.TransferEncoding)
+}
+
+func TestObjectStorageObjectV1ParseID(t *testing.T) {
+	container, name, err := objectStorageObjectV1ParseID("container/terraform/test/myfile.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "container", container)
+	assert.Equal(t, "terraform/test/myfile.txt", name)
+
+	for _, id := range []string{"container", "container/", "/object"} {
+		_, _, err := objectStorageObjectV1ParseID(id)
+		assert.Error(t, err)
+	}
+}
+
+func TestObjectStorageV1Metadata(t *testing.T) {
+	metadata := map[string]string{
+		"Foo":     "bar",
+		"Foo-Bar": "baz",
+		"Baz":     "qux",
+	}
+
+	configured := map[string]interface{}{
+		"Baz": "qux",
+	}
+
+	expected := map[string]string{
+		"foo":     "bar",
+		"foo-bar": "baz",
+		"Baz":     "qux",
+	}
+
+	assert.Equal(t, expected, objectStorageV1Metadata(metadata, configured))
+}
+
+func TestObjectStorageObjectV1SegmentSize(t *testing.T) {
+	const mib = 1024 * 1024
+	const gib = 1024 * mib
+
+	for _, c := range []struct {
+		segmentSize, size, expected int64
+	}{
+		{0, 5 * gib, 0},
+		{0, 5*gib + 1, gib},
+		{0, 2000 * gib, 2048 * mib},
+		{10 * mib, 5 * mib, 0},
+		{10 * mib, 15 * mib, 10 * mib},
+	} {
+		segmentSize, err := objectStorageObjectV1SegmentSize(c.segmentSize, c.size)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, segmentSize)
+	}
+
+	// Too many segments.
+	_, err := objectStorageObjectV1SegmentSize(mib, 5*gib)
+	assert.Error(t, err)
+
+	// Segments larger than the maximum object size.
+	_, err = objectStorageObjectV1SegmentSize(6*gib, 7*gib)
+	assert.Error(t, err)
+}
+
+func TestObjectStorageObjectV1Delete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/container/object", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		assert.Equal(t, "delete", r.URL.Query().Get("multipart-manifest"))
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "Number Deleted": 3,
+  "Number Not Found": 0,
+  "Response Status": "200 OK",
+  "Errors": []
+}`)
+	})
+
+	th.Mux.HandleFunc("/container/failed", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "Number Deleted": 0,
+  "Number Not Found": 0,
+  "Response Status": "400 Bad Request",
+  "Errors": [["/segments/object/slo/1/00000000", "409 Conflict"]]
+}`)
+	})
+
+	th.Mux.HandleFunc("/container/plain", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	assert.NoError(t, objectStorageObjectV1Delete(thclient.ServiceClient(), "container", "object"))
+	assert.NoError(t, objectStorageObjectV1Delete(thclient.ServiceClient(), "container", "plain"))
+	assert.Error(t, objectStorageObjectV1Delete(thclient.ServiceClient(), "container", "failed"))
+}
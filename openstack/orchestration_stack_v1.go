@@ -0,0 +1,316 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stackresources"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// orchestrationStackV1StateRefreshFunc waits for a stack action (CREATE,
+// UPDATE or DELETE) to finish. A failed action or a rollback is reported as
+// an error.
+func orchestrationStackV1StateRefreshFunc(client *gophercloud.ServiceClient, stackName, stackID, action string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		stack, err := stacks.Get(client, stackName, stackID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return stack, "DELETE_COMPLETE", nil
+			}
+
+			return nil, "", err
+		}
+
+		switch stack.Status {
+		case action + "_FAILED":
+			return stack, stack.Status, fmt.Errorf("The stack is in %s status: %s", stack.Status, stack.StatusReason)
+		case "ROLLBACK_COMPLETE", "ROLLBACK_FAILED":
+			if action != "DELETE" {
+				return stack, stack.Status, fmt.Errorf("The stack was rolled back (%s): %s", stack.Status, stack.StatusReason)
+			}
+		}
+
+		return stack, stack.Status, nil
+	}
+}
+
+// orchestrationStackV1NormalizeTemplate parses a JSON or YAML document into
+// a structure which can be compared regardless of the original format.
+func orchestrationStackV1NormalizeTemplate(template string) (interface{}, error) {
+	te := stacks.TE{
+		Bin: []byte(template),
+	}
+
+	if err := te.Parse(); err != nil {
+		return nil, err
+	}
+
+	// YAML maps are decoded with interface{} keys, which can't be encoded as
+	// JSON. Convert them and round-trip the result through JSON, so that
+	// numbers have the same type in both formats.
+	b, err := json.Marshal(orchestrationStackV1StringKeys(te.Parsed))
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized interface{}
+	if err := json.Unmarshal(b, &normalized); err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
+
+func orchestrationStackV1StringKeys(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[key] = orchestrationStackV1StringKeys(value)
+		}
+		return m
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprintf("%v", key)] = orchestrationStackV1StringKeys(value)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, value := range v {
+			l[i] = orchestrationStackV1StringKeys(value)
+		}
+		return l
+	}
+
+	return v
+}
+
+// orchestrationStackV1TemplateDiffSuppressFunc suppresses the diff between
+// templates or environments which only differ in their format, e.g. a YAML
+// template and the JSON template returned by Heat.
+func orchestrationStackV1TemplateDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldTemplate, err := orchestrationStackV1NormalizeTemplate(old)
+	if err != nil {
+		return false
+	}
+
+	newTemplate, err := orchestrationStackV1NormalizeTemplate(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldTemplate, newTemplate)
+}
+
+// orchestrationStackV1EnvironmentContains returns whether the environment
+// returned by Heat contains the configured environment. Heat merges the
+// parameters of a stack and its own defaults into the environment, so the
+// environment returned by Heat is usually a superset of the configured one.
+func orchestrationStackV1EnvironmentContains(environment map[string]interface{}, configured string) bool {
+	if configured == "" {
+		return true
+	}
+
+	normalized, err := orchestrationStackV1NormalizeTemplate(configured)
+	if err != nil {
+		return false
+	}
+
+	return orchestrationStackV1Contains(environment, normalized)
+}
+
+func orchestrationStackV1Contains(v, subset interface{}) bool {
+	switch subset := subset.(type) {
+	case map[string]interface{}:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return len(subset) == 0
+		}
+
+		for key, value := range subset {
+			if !orchestrationStackV1Contains(m[key], value) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		return reflect.DeepEqual(v, subset)
+	}
+
+	// Parameter values may be passed as strings or in their own type.
+	return fmt.Sprintf("%v", v) == fmt.Sprintf("%v", subset)
+}
+
+// orchestrationStackV1NonDefaultParameters returns the parameters of a stack
+// whose value differs from the default value in the template.
+func orchestrationStackV1NonDefaultParameters(template string, parameters map[string]string) (map[string]string, error) {
+	normalized, err := orchestrationStackV1NormalizeTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+
+	var definitions map[string]interface{}
+	if t, ok := normalized.(map[string]interface{}); ok {
+		definitions, _ = t["parameters"].(map[string]interface{})
+	}
+
+	m := make(map[string]string, len(parameters))
+	for k, v := range parameters {
+		definition, _ := definitions[k].(map[string]interface{})
+		if defaultValue, ok := definition["default"]; ok && orchestrationStackV1ParameterEqual(v, defaultValue) {
+			continue
+		}
+		m[k] = v
+	}
+
+	return m, nil
+}
+
+// orchestrationStackV1ParameterEqual compares the value of a parameter
+// returned by Heat with a default value of a template.
+func orchestrationStackV1ParameterEqual(value string, defaultValue interface{}) bool {
+	switch v := defaultValue.(type) {
+	case string:
+		return value == v
+	case float64:
+		return value == strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strings.EqualFold(value, strconv.FormatBool(v))
+	case []interface{}:
+		l := make([]string, len(v))
+		for i, item := range v {
+			l[i] = fmt.Sprintf("%v", item)
+		}
+		return value == strings.Join(l, ",")
+	}
+
+	// The value of a json parameter is returned as a JSON document.
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(parsed, defaultValue)
+}
+
+// orchestrationStackV1GetEnvironment retrieves the environment of a stack.
+// Gophercloud doesn't support the environment and the files of a stack yet.
+func orchestrationStackV1GetEnvironment(client *gophercloud.ServiceClient, stackName, stackID string) (map[string]interface{}, error) {
+	var r map[string]interface{}
+	_, err := client.Get(client.ServiceURL("stacks", stackName, stackID, "environment"), &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return r, err
+}
+
+// orchestrationStackV1GetFiles retrieves the files of a stack.
+func orchestrationStackV1GetFiles(client *gophercloud.ServiceClient, stackName, stackID string) (map[string]string, error) {
+	var r map[string]string
+	_, err := client.Get(client.ServiceURL("stacks", stackName, stackID, "files"), &r, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return r, err
+}
+
+func validateOrchestrationStackV1Template(v interface{}, k string) (ws []string, errors []error) {
+	template := stacks.Template{
+		TE: stacks.TE{
+			Bin: []byte(v.(string)),
+		},
+	}
+
+	if err := template.Validate(); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid template: %s", k, err))
+	}
+
+	return
+}
+
+func validateOrchestrationStackV1Environment(v interface{}, k string) (ws []string, errors []error) {
+	environment := stacks.Environment{
+		TE: stacks.TE{
+			Bin: []byte(v.(string)),
+		},
+	}
+
+	if err := environment.Validate(); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid environment: %s", k, err))
+	}
+
+	return
+}
+
+// flattenOrchestrationStackV1Outputs converts the outputs of a stack into a
+// map. Values which are not strings are encoded as JSON.
+func flattenOrchestrationStackV1Outputs(outputs []map[string]interface{}) map[string]string {
+	m := make(map[string]string, len(outputs))
+
+	for _, output := range outputs {
+		key, ok := output["output_key"].(string)
+		if !ok {
+			continue
+		}
+
+		switch value := output["output_value"].(type) {
+		case nil:
+			m[key] = ""
+		case string:
+			m[key] = value
+		default:
+			b, err := json.Marshal(value)
+			if err != nil {
+				m[key] = fmt.Sprintf("%v", value)
+				continue
+			}
+			m[key] = string(b)
+		}
+	}
+
+	return m
+}
+
+// flattenOrchestrationStackV1Parameters removes the pseudo parameters, which
+// are set by Heat, e.g. OS::stack_id, from the parameters of a stack.
+func flattenOrchestrationStackV1Parameters(parameters map[string]string) map[string]string {
+	m := make(map[string]string, len(parameters))
+
+	for k, v := range parameters {
+		if strings.HasPrefix(k, "OS::") {
+			continue
+		}
+		m[k] = v
+	}
+
+	return m
+}
+
+func flattenOrchestrationStackV1Resources(resources []stackresources.Resource) []map[string]interface{} {
+	l := make([]map[string]interface{}, 0, len(resources))
+
+	for _, r := range resources {
+		l = append(l, map[string]interface{}{
+			"name":          r.Name,
+			"physical_id":   r.PhysicalID,
+			"type":          r.Type,
+			"status":        r.Status,
+			"status_reason": r.StatusReason,
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,156 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testOrchestrationStackV1TemplateYAML = `
+heat_template_version: 2016-10-14
+parameters:
+  length:
+    type: number
+    default: 4
+resources:
+  random:
+    type: OS::Heat::RandomString
+    properties:
+      length: { get_param: length }
+`
+
+const testOrchestrationStackV1TemplateJSON = `{
+  "heat_template_version": "2016-10-14",
+  "parameters": {
+    "length": {
+      "type": "number",
+      "default": 4
+    }
+  },
+  "resources": {
+    "random": {
+      "type": "OS::Heat::RandomString",
+      "properties": {
+        "length": {
+          "get_param": "length"
+        }
+      }
+    }
+  }
+}`
+
+func TestOrchestrationStackV1TemplateDiffSuppressFunc(t *testing.T) {
+	assert.True(t, orchestrationStackV1TemplateDiffSuppressFunc("template",
+		testOrchestrationStackV1TemplateJSON, testOrchestrationStackV1TemplateYAML, nil))
+
+	assert.False(t, orchestrationStackV1TemplateDiffSuppressFunc("template",
+		testOrchestrationStackV1TemplateJSON, "heat_template_version: 2016-10-14\n", nil))
+
+	assert.False(t, orchestrationStackV1TemplateDiffSuppressFunc("template",
+		"", testOrchestrationStackV1TemplateYAML, nil))
+}
+
+func TestValidateOrchestrationStackV1Template(t *testing.T) {
+	_, errs := validateOrchestrationStackV1Template(testOrchestrationStackV1TemplateYAML, "template")
+	assert.Empty(t, errs)
+
+	_, errs = validateOrchestrationStackV1Template("resources: {}\n", "template")
+	assert.NotEmpty(t, errs)
+}
+
+func TestFlattenOrchestrationStackV1Outputs(t *testing.T) {
+	outputs := []map[string]interface{}{
+		{
+			"output_key":   "string",
+			"output_value": "foo",
+		},
+		{
+			"output_key":   "list",
+			"output_value": []interface{}{"foo", "bar"},
+		},
+		{
+			"output_key":   "empty",
+			"output_value": nil,
+		},
+	}
+
+	expected := map[string]string{
+		"string": "foo",
+		"list":   `["foo","bar"]`,
+		"empty":  "",
+	}
+
+	assert.Equal(t, expected, flattenOrchestrationStackV1Outputs(outputs))
+}
+
+func TestFlattenOrchestrationStackV1Parameters(t *testing.T) {
+	parameters := map[string]string{
+		"OS::stack_id":   "d1b3b6a3-f3a5-4f41-9a4c-4a1e4a0a2c4d",
+		"OS::stack_name": "stack_1",
+		"OS::project_id": "a0bc6f8e5f2f4fd1a1b24a6e8c3c3b5f",
+		"length":         "4",
+	}
+
+	expected := map[string]string{
+		"length": "4",
+	}
+
+	assert.Equal(t, expected, flattenOrchestrationStackV1Parameters(parameters))
+}
+
+func TestOrchestrationStackV1EnvironmentContains(t *testing.T) {
+	environment := map[string]interface{}{
+		"parameters": map[string]interface{}{
+			"length": "8",
+		},
+		"parameter_defaults": map[string]interface{}{},
+		"resource_registry": map[string]interface{}{
+			"OS::Test::Random": "random.yaml",
+			"resources":        map[string]interface{}{},
+		},
+		"event_sinks":           []interface{}{},
+		"encrypted_param_names": []interface{}{},
+	}
+
+	assert.True(t, orchestrationStackV1EnvironmentContains(environment, ""))
+	assert.True(t, orchestrationStackV1EnvironmentContains(environment,
+		"parameters:\n  length: 8\nresource_registry:\n  OS::Test::Random: random.yaml\n"))
+	assert.False(t, orchestrationStackV1EnvironmentContains(environment,
+		"parameters:\n  length: 4\n"))
+	assert.False(t, orchestrationStackV1EnvironmentContains(environment,
+		"resource_registry:\n  OS::Test::Other: other.yaml\n"))
+}
+
+func TestOrchestrationStackV1NonDefaultParameters(t *testing.T) {
+	template := `
+heat_template_version: 2016-10-14
+parameters:
+  length:
+    type: number
+    default: 4
+  enabled:
+    type: boolean
+    default: true
+  names:
+    type: comma_delimited_list
+    default: [foo, bar]
+  name:
+    type: string
+`
+
+	parameters := map[string]string{
+		"length":  "4",
+		"enabled": "True",
+		"names":   "foo,baz",
+		"name":    "foo",
+	}
+
+	expected := map[string]string{
+		"names": "foo,baz",
+		"name":  "foo",
+	}
+
+	actual, err := orchestrationStackV1NonDefaultParameters(template, parameters)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
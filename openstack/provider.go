@@ -220,13 +220,20 @@ func Provider() terraform.ResourceProvider {
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"openstack_blockstorage_availability_zones_v3":     dataSourceBlockStorageAvailabilityZonesV3(),
+			"openstack_blockstorage_backup_v3":                 dataSourceBlockStorageBackupV3(),
 			"openstack_blockstorage_snapshot_v2":               dataSourceBlockStorageSnapshotV2(),
 			"openstack_blockstorage_snapshot_v3":               dataSourceBlockStorageSnapshotV3(),
+			"openstack_blockstorage_quotaset_v3":               dataSourceBlockStorageQuotasetV3(),
+			"openstack_blockstorage_qos_v3":                    dataSourceBlockStorageQoSV3(),
+			"openstack_blockstorage_volume_type_v3":            dataSourceBlockStorageVolumeTypeV3(),
 			"openstack_compute_availability_zones_v2":          dataSourceComputeAvailabilityZonesV2(),
 			"openstack_compute_flavor_v2":                      dataSourceComputeFlavorV2(),
+			"openstack_compute_aggregate_v2":                   dataSourceComputeAggregateV2(),
 			"openstack_compute_keypair_v2":                     dataSourceComputeKeypairV2(),
+			"openstack_compute_quotaset_v2":                    dataSourceComputeQuotasetV2(),
 			"openstack_containerinfra_clustertemplate_v1":      dataSourceContainerInfraClusterTemplateV1(),
 			"openstack_containerinfra_cluster_v1":              dataSourceContainerInfraCluster(),
+			"openstack_dns_recordset_v2":                       dataSourceDNSRecordSetV2(),
 			"openstack_dns_zone_v2":                            dataSourceDNSZoneV2(),
 			"openstack_fw_policy_v1":                           dataSourceFWPolicyV1(),
 			"openstack_identity_role_v3":                       dataSourceIdentityRoleV3(),
@@ -236,6 +243,8 @@ func Provider() terraform.ResourceProvider {
 			"openstack_identity_endpoint_v3":                   dataSourceIdentityEndpointV3(),
 			"openstack_identity_group_v3":                      dataSourceIdentityGroupV3(),
 			"openstack_images_image_v2":                        dataSourceImagesImageV2(),
+			"openstack_keymanager_secret_v1":                   dataSourceKeyManagerSecretV1(),
+			"openstack_keymanager_container_v1":                dataSourceKeyManagerContainerV1(),
 			"openstack_networking_addressscope_v2":             dataSourceNetworkingAddressScopeV2(),
 			"openstack_networking_network_v2":                  dataSourceNetworkingNetworkV2(),
 			"openstack_networking_subnet_v2":                   dataSourceNetworkingSubnetV2(),
@@ -246,19 +255,37 @@ func Provider() terraform.ResourceProvider {
 			"openstack_networking_port_v2":                     dataSourceNetworkingPortV2(),
 			"openstack_networking_port_ids_v2":                 dataSourceNetworkingPortIDsV2(),
 			"openstack_networking_trunk_v2":                    dataSourceNetworkingTrunkV2(),
+			"openstack_networking_quota_v2":                    dataSourceNetworkingQuotaV2(),
+			"openstack_objectstorage_account_v1":               dataSourceObjectStorageAccountV1(),
+			"openstack_objectstorage_container_v1":             dataSourceObjectStorageContainerV1(),
+			"openstack_objectstorage_object_v1":                dataSourceObjectStorageObjectV1(),
+			"openstack_orchestration_stack_v1":                 dataSourceOrchestrationStackV1(),
 			"openstack_sharedfilesystem_availability_zones_v2": dataSourceSharedFilesystemAvailabilityZonesV2(),
 			"openstack_sharedfilesystem_sharenetwork_v2":       dataSourceSharedFilesystemShareNetworkV2(),
 			"openstack_sharedfilesystem_share_v2":              dataSourceSharedFilesystemShareV2(),
 			"openstack_sharedfilesystem_snapshot_v2":           dataSourceSharedFilesystemSnapshotV2(),
+
+			"openstack_networking_qos_policy_v2":                 dataSourceNetworkingQoSPolicyV2(),
+			"openstack_networking_qos_bandwidth_limit_rule_v2":   dataSourceNetworkingQoSBandwidthLimitRuleV2(),
+			"openstack_networking_qos_dscp_marking_rule_v2":      dataSourceNetworkingQoSDSCPMarkingRuleV2(),
+			"openstack_networking_qos_minimum_bandwidth_rule_v2": dataSourceNetworkingQoSMinimumBandwidthRuleV2(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"openstack_blockstorage_backup_v3":                resourceBlockStorageBackupV3(),
 			"openstack_blockstorage_volume_v1":                resourceBlockStorageVolumeV1(),
 			"openstack_blockstorage_volume_v2":                resourceBlockStorageVolumeV2(),
 			"openstack_blockstorage_volume_v3":                resourceBlockStorageVolumeV3(),
 			"openstack_blockstorage_volume_attach_v2":         resourceBlockStorageVolumeAttachV2(),
 			"openstack_blockstorage_volume_attach_v3":         resourceBlockStorageVolumeAttachV3(),
+			"openstack_blockstorage_quotaset_v3":              resourceBlockStorageQuotasetV3(),
+			"openstack_blockstorage_qos_v3":                   resourceBlockStorageQoSV3(),
+			"openstack_blockstorage_qos_association_v3":       resourceBlockStorageQoSAssociationV3(),
+			"openstack_blockstorage_snapshot_v3":              resourceBlockStorageSnapshotV3(),
+			"openstack_blockstorage_volume_type_v3":           resourceBlockStorageVolumeTypeV3(),
+			"openstack_blockstorage_volume_type_access_v3":    resourceBlockStorageVolumeTypeAccessV3(),
 			"openstack_compute_flavor_v2":                     resourceComputeFlavorV2(),
+			"openstack_compute_aggregate_v2":                  resourceComputeAggregateV2(),
 			"openstack_compute_flavor_access_v2":              resourceComputeFlavorAccessV2(),
 			"openstack_compute_instance_v2":                   resourceComputeInstanceV2(),
 			"openstack_compute_interface_attach_v2":           resourceComputeInterfaceAttachV2(),
@@ -268,14 +295,18 @@ func Provider() terraform.ResourceProvider {
 			"openstack_compute_floatingip_v2":                 resourceComputeFloatingIPV2(),
 			"openstack_compute_floatingip_associate_v2":       resourceComputeFloatingIPAssociateV2(),
 			"openstack_compute_volume_attach_v2":              resourceComputeVolumeAttachV2(),
+			"openstack_compute_quotaset_v2":                   resourceComputeQuotasetV2(),
 			"openstack_containerinfra_clustertemplate_v1":     resourceContainerInfraClusterTemplateV1(),
 			"openstack_containerinfra_cluster_v1":             resourceContainerInfraClusterV1(),
 			"openstack_db_instance_v1":                        resourceDatabaseInstanceV1(),
 			"openstack_db_user_v1":                            resourceDatabaseUserV1(),
 			"openstack_db_configuration_v1":                   resourceDatabaseConfigurationV1(),
 			"openstack_db_database_v1":                        resourceDatabaseDatabaseV1(),
+			"openstack_dns_ptr_record_v2":                     resourceDNSPTRRecordV2(),
 			"openstack_dns_recordset_v2":                      resourceDNSRecordSetV2(),
 			"openstack_dns_zone_v2":                           resourceDNSZoneV2(),
+			"openstack_dns_transfer_request_v2":               resourceDNSTransferRequestV2(),
+			"openstack_dns_transfer_accept_v2":                resourceDNSTransferAcceptV2(),
 			"openstack_fw_firewall_v1":                        resourceFWFirewallV1(),
 			"openstack_fw_policy_v1":                          resourceFWPolicyV1(),
 			"openstack_fw_rule_v1":                            resourceFWRuleV1(),
@@ -285,6 +316,10 @@ func Provider() terraform.ResourceProvider {
 			"openstack_identity_user_v3":                      resourceIdentityUserV3(),
 			"openstack_identity_application_credential_v3":    resourceIdentityApplicationCredentialV3(),
 			"openstack_images_image_v2":                       resourceImagesImageV2(),
+			"openstack_images_image_access_v2":                resourceImagesImageAccessV2(),
+			"openstack_images_image_access_accept_v2":         resourceImagesImageAccessAcceptV2(),
+			"openstack_keymanager_secret_v1":                  resourceKeyManagerSecretV1(),
+			"openstack_keymanager_container_v1":               resourceKeyManagerContainerV1(),
 			"openstack_lb_member_v1":                          resourceLBMemberV1(),
 			"openstack_lb_monitor_v1":                         resourceLBMonitorV1(),
 			"openstack_lb_pool_v1":                            resourceLBPoolV1(),
@@ -293,9 +328,12 @@ func Provider() terraform.ResourceProvider {
 			"openstack_lb_listener_v2":                        resourceListenerV2(),
 			"openstack_lb_pool_v2":                            resourcePoolV2(),
 			"openstack_lb_member_v2":                          resourceMemberV2(),
+			"openstack_lb_members_v2":                         resourceMembersV2(),
 			"openstack_lb_monitor_v2":                         resourceMonitorV2(),
 			"openstack_lb_l7policy_v2":                        resourceL7PolicyV2(),
 			"openstack_lb_l7rule_v2":                          resourceL7RuleV2(),
+			"openstack_lb_flavorprofile_v2":                   resourceLoadBalancerFlavorProfileV2(),
+			"openstack_lb_flavor_v2":                          resourceLoadBalancerFlavorV2(),
 			"openstack_networking_floatingip_v2":              resourceNetworkingFloatingIPV2(),
 			"openstack_networking_floatingip_associate_v2":    resourceNetworkingFloatingIPAssociateV2(),
 			"openstack_networking_network_v2":                 resourceNetworkingNetworkV2(),
@@ -311,9 +349,13 @@ func Provider() terraform.ResourceProvider {
 			"openstack_networking_subnetpool_v2":              resourceNetworkingSubnetPoolV2(),
 			"openstack_networking_addressscope_v2":            resourceNetworkingAddressScopeV2(),
 			"openstack_networking_trunk_v2":                   resourceNetworkingTrunkV2(),
+			"openstack_networking_rbac_policy_v2":             resourceNetworkingRBACPolicyV2(),
+			"openstack_networking_quota_v2":                   resourceNetworkingQuotaV2(),
+			"openstack_objectstorage_account_v1":              resourceObjectStorageAccountV1(),
 			"openstack_objectstorage_container_v1":            resourceObjectStorageContainerV1(),
 			"openstack_objectstorage_object_v1":               resourceObjectStorageObjectV1(),
 			"openstack_objectstorage_tempurl_v1":              resourceObjectstorageTempurlV1(),
+			"openstack_orchestration_stack_v1":                resourceOrchestrationStackV1(),
 			"openstack_vpnaas_ipsec_policy_v2":                resourceIPSecPolicyV2(),
 			"openstack_vpnaas_service_v2":                     resourceServiceV2(),
 			"openstack_vpnaas_ike_policy_v2":                  resourceIKEPolicyV2(),
@@ -323,6 +365,14 @@ func Provider() terraform.ResourceProvider {
 			"openstack_sharedfilesystem_sharenetwork_v2":      resourceSharedFilesystemShareNetworkV2(),
 			"openstack_sharedfilesystem_share_v2":             resourceSharedFilesystemShareV2(),
 			"openstack_sharedfilesystem_share_access_v2":      resourceSharedFilesystemShareAccessV2(),
+
+			"openstack_networking_qos_policy_v2":                 resourceNetworkingQoSPolicyV2(),
+			"openstack_networking_qos_bandwidth_limit_rule_v2":   resourceNetworkingQoSBandwidthLimitRuleV2(),
+			"openstack_networking_qos_dscp_marking_rule_v2":      resourceNetworkingQoSDSCPMarkingRuleV2(),
+			"openstack_networking_qos_minimum_bandwidth_rule_v2": resourceNetworkingQoSMinimumBandwidthRuleV2(),
+
+			"openstack_blockstorage_volume_transfer_v3":        resourceBlockStorageVolumeTransferV3(),
+			"openstack_blockstorage_volume_transfer_accept_v3": resourceBlockStorageVolumeTransferAcceptV3(),
 		},
 
 		ConfigureFunc: configureProvider,
@@ -13,6 +13,7 @@ import (
 )
 
 var (
+	OS_BACKUP_ENVIRONMENT           = os.Getenv("OS_BACKUP_ENVIRONMENT")
 	OS_DB_ENVIRONMENT               = os.Getenv("OS_DB_ENVIRONMENT")
 	OS_DB_DATASTORE_VERSION         = os.Getenv("OS_DB_DATASTORE_VERSION")
 	OS_DB_DATASTORE_TYPE            = os.Getenv("OS_DB_DATASTORE_TYPE")
@@ -35,6 +36,8 @@ var (
 	OS_CONTAINER_INFRA_ENVIRONMENT  = os.Getenv("OS_CONTAINER_INFRA_ENVIRONMENT")
 	OS_SFS_ENVIRONMENT              = os.Getenv("OS_SFS_ENVIRONMENT")
 	OS_TRANSPARENT_VLAN_ENVIRONMENT = os.Getenv("OS_TRANSPARENT_VLAN_ENVIRONMENT")
+	OS_KEYMANAGER_ENVIRONMENT       = os.Getenv("OS_KEYMANAGER_ENVIRONMENT")
+	OS_ORCHESTRATION_ENVIRONMENT    = os.Getenv("OS_ORCHESTRATION_ENVIRONMENT")
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -107,6 +110,14 @@ func testAccPreCheckSwift(t *testing.T) {
 	}
 }
 
+func testAccPreCheckBackup(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_BACKUP_ENVIRONMENT == "" {
+		t.Skip("This environment does not support Block Storage Backup tests")
+	}
+}
+
 func testAccPreCheckDatabase(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
@@ -123,6 +134,14 @@ func testAccPreCheckLB(t *testing.T) {
 	}
 }
 
+func testAccPreCheckOctavia(t *testing.T) {
+	testAccPreCheckLB(t)
+
+	if OS_USE_OCTAVIA == "" {
+		t.Skip("This environment does not support Octavia tests")
+	}
+}
+
 func testAccPreCheckFW(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
@@ -157,6 +176,22 @@ func testAccPreCheckSFS(t *testing.T) {
 	*/
 }
 
+func testAccPreCheckKeyManager(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_KEYMANAGER_ENVIRONMENT == "" {
+		t.Skip("This environment does not support Barbican Key Manager tests")
+	}
+}
+
+func testAccPreCheckOrchestration(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_ORCHESTRATION_ENVIRONMENT == "" {
+		t.Skip("This environment does not support Orchestration tests")
+	}
+}
+
 func testAccPreOnlineResize(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
@@ -0,0 +1,280 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageBackupV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageBackupV3Create,
+		Read:   resourceBlockStorageBackupV3Read,
+		Update: resourceBlockStorageBackupV3Update,
+		Delete: resourceBlockStorageBackupV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"container": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"incremental": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			// Computed-only
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"has_dependent_backups": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageBackupV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	metadata := d.Get("metadata").(map[string]interface{})
+	if len(metadata) > 0 {
+		supported, err := blockStorageBackupV3MetadataSupported(blockStorageClient)
+		if err != nil {
+			return fmt.Errorf("Error querying the OpenStack block storage API versions: %s", err)
+		}
+
+		if !supported {
+			return fmt.Errorf("Error creating openstack_blockstorage_backup_v3: "+
+				"metadata requires the block storage API microversion %s", blockStorageBackupV3MetadataMicroversion)
+		}
+
+		blockStorageClient.Microversion = blockStorageBackupV3MetadataMicroversion
+	}
+
+	createOpts := backups.CreateOpts{
+		VolumeID:    d.Get("volume_id").(string),
+		SnapshotID:  d.Get("snapshot_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Container:   d.Get("container").(string),
+		Incremental: d.Get("incremental").(bool),
+		Force:       d.Get("force").(bool),
+		Metadata:    expandToMapStringString(metadata),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_backup_v3 create options: %#v", createOpts)
+
+	b, err := backups.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_backup_v3: %s", err)
+	}
+
+	d.SetId(b.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageBackupV3StateRefreshFunc(blockStorageClient, b.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for openstack_blockstorage_backup_v3 %s to become ready: %s", b.ID, err)
+	}
+
+	return resourceBlockStorageBackupV3Read(d, meta)
+}
+
+func resourceBlockStorageBackupV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	metadataSupported, err := blockStorageBackupV3MetadataSupported(blockStorageClient)
+	if err != nil {
+		return fmt.Errorf("Error querying the OpenStack block storage API versions: %s", err)
+	}
+
+	if metadataSupported {
+		blockStorageClient.Microversion = blockStorageBackupV3MetadataMicroversion
+	}
+
+	result := backups.Get(blockStorageClient, d.Id())
+	b, err := result.Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_backup_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_backup_v3 %s: %#v", d.Id(), b)
+
+	d.Set("volume_id", b.VolumeID)
+	d.Set("snapshot_id", b.SnapshotID)
+	d.Set("name", b.Name)
+	d.Set("description", b.Description)
+	d.Set("container", b.Container)
+	d.Set("incremental", b.IsIncremental)
+	d.Set("size", b.Size)
+	d.Set("object_count", b.ObjectCount)
+	d.Set("status", b.Status)
+	d.Set("has_dependent_backups", b.HasDependentBackups)
+	d.Set("region", GetRegion(d, config))
+
+	// The metadata of a backup is only returned by the newer microversions.
+	if metadataSupported {
+		m, err := result.ExtractMetadata()
+		if err != nil {
+			return fmt.Errorf("Error retrieving openstack_blockstorage_backup_v3 %s metadata: %s", d.Id(), err)
+		}
+
+		if err := d.Set("metadata", m); err != nil {
+			log.Printf("[DEBUG] Unable to set openstack_blockstorage_backup_v3 %s metadata: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func resourceBlockStorageBackupV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	blockStorageClient.Microversion = blockStorageBackupV3UpdateMicroversion
+
+	var updateOpts blockStorageBackupV3UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("metadata") {
+		blockStorageClient.Microversion = blockStorageBackupV3MetadataMicroversion
+		metadata := d.Get("metadata").(map[string]interface{})
+		m := expandToMapStringString(metadata)
+		updateOpts.Metadata = &m
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_backup_v3 %s update options: %#v", d.Id(), updateOpts)
+	err = blockStorageBackupV3Update(blockStorageClient, d.Id(), updateOpts)
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_blockstorage_backup_v3 %s: %s", d.Id(), err)
+	}
+
+	return resourceBlockStorageBackupV3Read(d, meta)
+}
+
+func resourceBlockStorageBackupV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if err := backups.Delete(blockStorageClient, d.Id()).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_blockstorage_backup_v3")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting", "available"},
+		Target:     []string{"deleted"},
+		Refresh:    blockStorageBackupV3StateRefreshFunc(blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_blockstorage_backup_v3 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/backups"
+)
+
+func TestAccBlockStorageV3Backup_basic(t *testing.T) {
+	var backup backups.Backup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckBackup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3BackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3Backup_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3BackupExists("openstack_blockstorage_backup_v3.backup_1", &backup),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "name", "backup_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "status", "available"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "incremental", "false"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_backup_v3.backup_1", "volume_id",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3Backup_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3BackupExists("openstack_blockstorage_backup_v3.backup_1", &backup),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "name", "backup_1-updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "description", "first test backup"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3Backup_incremental(t *testing.T) {
+	var backup backups.Backup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckBackup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3BackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3Backup_incremental,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3BackupExists("openstack_blockstorage_backup_v3.backup_2", &backup),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "has_dependent_backups", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_2", "incremental", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3Backup_metadata(t *testing.T) {
+	var backup backups.Backup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckBackup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3BackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3Backup_metadata,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3BackupExists("openstack_blockstorage_backup_v3.backup_1", &backup),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "metadata.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3Backup_metadataUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3BackupExists("openstack_blockstorage_backup_v3.backup_1", &backup),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "metadata.foo", "baz"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3Backup_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3BackupExists("openstack_blockstorage_backup_v3.backup_1", &backup),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_backup_v3.backup_1", "metadata.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3Backup_restore(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckBackup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3BackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3Backup_restore,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_v3.volume_2", "backup_id",
+						"openstack_blockstorage_backup_v3.backup_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_2", "size", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3BackupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_backup_v3" {
+			continue
+		}
+
+		_, err := backups.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Backup still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3BackupExists(n string, backup *backups.Backup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := backups.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Backup not found")
+		}
+
+		*backup = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3Backup_volume = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+`
+
+var testAccBlockStorageV3Backup_basic = fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  name = "backup_1"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+`, testAccBlockStorageV3Backup_volume)
+
+var testAccBlockStorageV3Backup_update = fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  name = "backup_1-updated"
+  description = "first test backup"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+`, testAccBlockStorageV3Backup_volume)
+
+var testAccBlockStorageV3Backup_incremental = fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  name = "backup_1"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+
+resource "openstack_blockstorage_backup_v3" "backup_2" {
+  name = "backup_2"
+  volume_id = "${openstack_blockstorage_backup_v3.backup_1.volume_id}"
+  incremental = true
+}
+`, testAccBlockStorageV3Backup_volume)
+
+var testAccBlockStorageV3Backup_metadata = fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  name = "backup_1"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+
+  metadata = {
+    foo = "bar"
+  }
+}
+`, testAccBlockStorageV3Backup_volume)
+
+var testAccBlockStorageV3Backup_metadataUpdate = fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_backup_v3" "backup_1" {
+  name = "backup_1"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+
+  metadata = {
+    foo = "baz"
+  }
+}
+`, testAccBlockStorageV3Backup_volume)
+
+var testAccBlockStorageV3Backup_restore = fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_volume_v3" "volume_2" {
+  name = "volume_2"
+  size = 1
+  backup_id = "${openstack_blockstorage_backup_v3.backup_1.id}"
+}
+`, testAccBlockStorageV3Backup_basic)
@@ -0,0 +1,106 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageQoSAssociationV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageQoSAssociationV3Create,
+		Read:   resourceBlockStorageQoSAssociationV3Read,
+		Delete: resourceBlockStorageQoSAssociationV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"qos_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"volume_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageQoSAssociationV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	qosID := d.Get("qos_id").(string)
+	volumeTypeID := d.Get("volume_type_id").(string)
+
+	log.Printf("[DEBUG] openstack_blockstorage_qos_association_v3 associating QoS %s with volume type %s", qosID, volumeTypeID)
+	if err := blockStorageQoSV3Associate(blockStorageClient, qosID, volumeTypeID); err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_qos_association_v3: %s", err)
+	}
+
+	d.SetId(blockStorageQoSAssociationV3ID(qosID, volumeTypeID))
+
+	return resourceBlockStorageQoSAssociationV3Read(d, meta)
+}
+
+func resourceBlockStorageQoSAssociationV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	qosID, volumeTypeID, err := blockStorageQoSAssociationV3ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	association, err := blockStorageQoSV3GetAssociation(blockStorageClient, qosID, volumeTypeID)
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_qos_association_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_qos_association_v3 %s: %#v", d.Id(), association)
+
+	d.Set("qos_id", qosID)
+	d.Set("volume_type_id", association.ID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageQoSAssociationV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	qosID, volumeTypeID, err := blockStorageQoSAssociationV3ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = blockStorageQoSV3Disassociate(blockStorageClient, qosID, volumeTypeID)
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_blockstorage_qos_association_v3")
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBlockStorageV3QoSAssociation_basic(t *testing.T) {
+	qosName := acctest.RandomWithPrefix("tf-acc-qos")
+	typeName := acctest.RandomWithPrefix("tf-acc-type")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3QoSAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3QoSAssociation_basic(qosName, typeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QoSAssociationExists("openstack_blockstorage_qos_association_v3.association_1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_qos_association_v3.association_1", "qos_id",
+						"openstack_blockstorage_qos_v3.qos_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_qos_association_v3.association_1", "volume_type_id",
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3QoSAssociationDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_qos_association_v3" {
+			continue
+		}
+
+		qosID, volumeTypeID, err := blockStorageQoSAssociationV3ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = blockStorageQoSV3GetAssociation(blockStorageClient, qosID, volumeTypeID)
+		if err == nil {
+			return fmt.Errorf("QoS association still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3QoSAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		qosID, volumeTypeID, err := blockStorageQoSAssociationV3ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = blockStorageQoSV3GetAssociation(blockStorageClient, qosID, volumeTypeID)
+
+		return err
+	}
+}
+
+func testAccBlockStorageV3QoSAssociation_basic(qosName, typeName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "%s"
+}
+
+resource "openstack_blockstorage_qos_association_v3" "association_1" {
+  qos_id = "${openstack_blockstorage_qos_v3.qos_1.id}"
+  volume_type_id = "${openstack_blockstorage_volume_type_v3.volume_type_1.id}"
+}
+`, testAccBlockStorageV3QoS_basic(qosName), typeName)
+}
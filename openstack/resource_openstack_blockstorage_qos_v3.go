@@ -0,0 +1,160 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceBlockStorageQoSV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageQoSV3Create,
+		Read:   resourceBlockStorageQoSV3Read,
+		Update: resourceBlockStorageQoSV3Update,
+		Delete: resourceBlockStorageQoSV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"consumer": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "back-end",
+				ValidateFunc: validation.StringInSlice([]string{
+					"front-end", "back-end", "both",
+				}, false),
+			},
+
+			"specs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageQoSV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	specs := d.Get("specs").(map[string]interface{})
+	createOpts := blockStorageQoSV3CreateOpts{
+		Name:     d.Get("name").(string),
+		Consumer: d.Get("consumer").(string),
+		Specs:    expandToMapStringString(specs),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_qos_v3 create options: %#v", createOpts)
+	qos, err := blockStorageQoSV3Create(blockStorageClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_qos_v3: %s", err)
+	}
+
+	d.SetId(qos.ID)
+
+	return resourceBlockStorageQoSV3Read(d, meta)
+}
+
+func resourceBlockStorageQoSV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	qos, err := blockStorageQoSV3Get(blockStorageClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_qos_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_qos_v3 %s: %#v", d.Id(), qos)
+
+	d.Set("name", qos.Name)
+	d.Set("consumer", qos.Consumer)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("specs", qos.Specs); err != nil {
+		log.Printf("[WARN] Unable to set specs for openstack_blockstorage_qos_v3 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageQoSV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	keys := make(map[string]string)
+
+	if d.HasChange("consumer") {
+		keys["consumer"] = d.Get("consumer").(string)
+	}
+
+	if d.HasChange("specs") {
+		oldSpecs, newSpecs := d.GetChange("specs")
+		newSpecsRaw := newSpecs.(map[string]interface{})
+
+		var removed []string
+		for oldKey := range oldSpecs.(map[string]interface{}) {
+			if _, ok := newSpecsRaw[oldKey]; !ok {
+				removed = append(removed, oldKey)
+			}
+		}
+
+		if len(removed) > 0 {
+			log.Printf("[DEBUG] openstack_blockstorage_qos_v3 %s deleting specs: %v", d.Id(), removed)
+			if err := blockStorageQoSV3DeleteKeys(blockStorageClient, d.Id(), removed); err != nil {
+				return fmt.Errorf("Error deleting specs from openstack_blockstorage_qos_v3 %s: %s", d.Id(), err)
+			}
+		}
+
+		for k, v := range expandToMapStringString(newSpecsRaw) {
+			keys[k] = v
+		}
+	}
+
+	if len(keys) > 0 {
+		log.Printf("[DEBUG] openstack_blockstorage_qos_v3 %s update options: %#v", d.Id(), keys)
+		if err := blockStorageQoSV3SetKeys(blockStorageClient, d.Id(), keys); err != nil {
+			return fmt.Errorf("Error updating openstack_blockstorage_qos_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBlockStorageQoSV3Read(d, meta)
+}
+
+func resourceBlockStorageQoSV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if err := blockStorageQoSV3Delete(blockStorageClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_blockstorage_qos_v3")
+	}
+
+	return nil
+}
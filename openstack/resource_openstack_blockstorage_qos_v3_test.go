@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBlockStorageV3QoS_basic(t *testing.T) {
+	var qos blockStorageQoSV3
+	qosName := acctest.RandomWithPrefix("tf-acc-qos")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3QoSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3QoS_basic(qosName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QoSExists("openstack_blockstorage_qos_v3.qos_1", &qos),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "name", qosName),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "consumer", "back-end"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "specs.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "specs.read_iops_sec", "20000"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3QoS_update(qosName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QoSExists("openstack_blockstorage_qos_v3.qos_1", &qos),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "consumer", "front-end"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "specs.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "specs.total_bytes_sec", "104857600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3QoSDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_qos_v3" {
+			continue
+		}
+
+		_, err := blockStorageQoSV3Get(blockStorageClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("QoS still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3QoSExists(n string, qos *blockStorageQoSV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := blockStorageQoSV3Get(blockStorageClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("QoS not found")
+		}
+
+		*qos = *found
+
+		return nil
+	}
+}
+
+func testAccBlockStorageV3QoS_basic(qosName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_qos_v3" "qos_1" {
+  name = "%s"
+
+  specs = {
+    read_iops_sec = "20000"
+    write_iops_sec = "10000"
+  }
+}
+`, qosName)
+}
+
+func testAccBlockStorageV3QoS_update(qosName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_qos_v3" "qos_1" {
+  name = "%s"
+  consumer = "front-end"
+
+  specs = {
+    total_bytes_sec = "104857600"
+  }
+}
+`, qosName)
+}
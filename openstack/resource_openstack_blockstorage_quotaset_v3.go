@@ -0,0 +1,161 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageQuotasetV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageQuotasetV3Create,
+		Read:   resourceBlockStorageQuotasetV3Read,
+		Update: resourceBlockStorageQuotasetV3Update,
+		Delete: resourceBlockStorageQuotasetV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"volumes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"snapshots": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"gigabytes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"per_volume_gigabytes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"backups": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"backup_gigabytes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"groups": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageQuotasetV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	updateOpts := expandBlockStorageQuotasetV3UpdateOpts(d)
+
+	log.Printf("[DEBUG] openstack_blockstorage_quotaset_v3 %s create options: %#v", projectID, updateOpts)
+
+	_, err = quotasets.Update(blockStorageClient, projectID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_quotaset_v3 %s: %s", projectID, err)
+	}
+
+	d.SetId(projectID)
+
+	log.Printf("[DEBUG] Created openstack_blockstorage_quotaset_v3 %s", projectID)
+
+	return resourceBlockStorageQuotasetV3Read(d, meta)
+}
+
+func resourceBlockStorageQuotasetV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	q, err := quotasets.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_quotaset_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_quotaset_v3 %s: %#v", d.Id(), q)
+
+	for k, v := range flattenBlockStorageQuotasetV3(q) {
+		d.Set(k, v)
+	}
+
+	d.Set("project_id", d.Id())
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageQuotasetV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	updateOpts := expandBlockStorageQuotasetV3UpdateOpts(d)
+
+	log.Printf("[DEBUG] openstack_blockstorage_quotaset_v3 %s update options: %#v", d.Id(), updateOpts)
+
+	_, err = quotasets.Update(blockStorageClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_blockstorage_quotaset_v3 %s: %s", d.Id(), err)
+	}
+
+	return resourceBlockStorageQuotasetV3Read(d, meta)
+}
+
+// resourceBlockStorageQuotasetV3Delete resets the quotas of the project to
+// the defaults.
+func resourceBlockStorageQuotasetV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if err := quotasets.Delete(blockStorageClient, d.Id()).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "Error resetting openstack_blockstorage_quotaset_v3")
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+)
+
+func TestAccBlockStorageV3QuotasetBasic(t *testing.T) {
+	var quota quotasets.QuotaSet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3QuotasetBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QuotasetExists("openstack_blockstorage_quotaset_v3.quotaset_1", &quota),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "volumes", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "snapshots", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "gigabytes", "100"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "backups", "4"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3QuotasetUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QuotasetExists("openstack_blockstorage_quotaset_v3.quotaset_1", &quota),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "volumes", "5"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "snapshots", "5"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "gigabytes", "50"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "backups", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3QuotasetExists(n string, quota *quotasets.QuotaSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := quotasets.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		*quota = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3QuotasetBasic = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_blockstorage_quotaset_v3" "quotaset_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  volumes    = 10
+  snapshots  = 10
+  gigabytes  = 100
+  backups    = 4
+}
+`
+
+const testAccBlockStorageV3QuotasetUpdate = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_blockstorage_quotaset_v3" "quotaset_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  volumes    = 5
+  snapshots  = 5
+  gigabytes  = 50
+  backups    = 0
+}
+`
@@ -0,0 +1,220 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageSnapshotV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageSnapshotV3Create,
+		Read:   resourceBlockStorageSnapshotV3Read,
+		Update: resourceBlockStorageSnapshotV3Update,
+		Delete: resourceBlockStorageSnapshotV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			// Computed-only
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageSnapshotV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	metadata := d.Get("metadata").(map[string]interface{})
+	createOpts := snapshots.CreateOpts{
+		VolumeID:    d.Get("volume_id").(string),
+		Force:       d.Get("force").(bool),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Metadata:    expandToMapStringString(metadata),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_snapshot_v3 create options: %#v", createOpts)
+
+	s, err := snapshots.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_snapshot_v3: %s", err)
+	}
+
+	d.SetId(s.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageSnapshotV3StateRefreshFunc(blockStorageClient, s.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for openstack_blockstorage_snapshot_v3 %s to become ready: %s", s.ID, err)
+	}
+
+	return resourceBlockStorageSnapshotV3Read(d, meta)
+}
+
+func resourceBlockStorageSnapshotV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	s, err := snapshots.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_snapshot_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_snapshot_v3 %s: %#v", d.Id(), s)
+
+	d.Set("volume_id", s.VolumeID)
+	d.Set("name", s.Name)
+	d.Set("description", s.Description)
+	d.Set("size", s.Size)
+	d.Set("status", s.Status)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("metadata", s.Metadata); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_blockstorage_snapshot_v3 %s metadata: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageSnapshotV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts blockStorageSnapshotV3UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_blockstorage_snapshot_v3 %s update options: %#v", d.Id(), updateOpts)
+		err = blockStorageSnapshotV3Update(blockStorageClient, d.Id(), updateOpts)
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_blockstorage_snapshot_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("metadata") {
+		metadata := d.Get("metadata").(map[string]interface{})
+		metadataOpts := SnapshotV3UpdateMetadataOpts{
+			Metadata: expandToMapStringString(metadata),
+		}
+
+		log.Printf("[DEBUG] openstack_blockstorage_snapshot_v3 %s metadata update options: %#v", d.Id(), metadataOpts)
+		_, err = snapshots.UpdateMetadata(blockStorageClient, d.Id(), metadataOpts).ExtractMetadata()
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_blockstorage_snapshot_v3 %s metadata: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBlockStorageSnapshotV3Read(d, meta)
+}
+
+func resourceBlockStorageSnapshotV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if err := snapshots.Delete(blockStorageClient, d.Id()).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_blockstorage_snapshot_v3")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting", "available"},
+		Target:     []string{"deleted"},
+		Refresh:    blockStorageSnapshotV3StateRefreshFunc(blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_blockstorage_snapshot_v3 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/snapshots"
+)
+
+func TestAccBlockStorageV3Snapshot_basic(t *testing.T) {
+	var snapshot snapshots.Snapshot
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3SnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3Snapshot_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists("openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "name", "snapshot_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "status", "available"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "size", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "metadata.foo", "bar"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "volume_id",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3Snapshot_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists("openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "name", "snapshot_1-updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "description", "first test snapshot"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "metadata.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "metadata.foo", "baz"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3Snapshot_noMetadata,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists("openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "metadata.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3Snapshot_force(t *testing.T) {
+	var snapshot snapshots.Snapshot
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3SnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3Snapshot_force(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists("openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "force", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "status", "available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3SnapshotDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_snapshot_v3" {
+			continue
+		}
+
+		_, err := snapshots.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Snapshot still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3SnapshotExists(n string, snapshot *snapshots.Snapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := snapshots.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Snapshot not found")
+		}
+
+		*snapshot = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3Snapshot_volume = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+`
+
+var testAccBlockStorageV3Snapshot_basic = fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name = "snapshot_1"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+
+  metadata = {
+    foo = "bar"
+  }
+}
+`, testAccBlockStorageV3Snapshot_volume)
+
+var testAccBlockStorageV3Snapshot_update = fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name = "snapshot_1-updated"
+  description = "first test snapshot"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+
+  metadata = {
+    foo = "baz"
+  }
+}
+`, testAccBlockStorageV3Snapshot_volume)
+
+var testAccBlockStorageV3Snapshot_noMetadata = fmt.Sprintf(`
+%s
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name = "snapshot_1-updated"
+  description = "first test snapshot"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+`, testAccBlockStorageV3Snapshot_volume)
+
+func testAccBlockStorageV3Snapshot_force() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "openstack_compute_volume_attach_v2" "va_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name = "snapshot_1"
+  volume_id = "${openstack_compute_volume_attach_v2.va_1.volume_id}"
+  force = true
+}
+`, testAccBlockStorageV3Snapshot_volume, OS_NETWORK_ID)
+}
@@ -0,0 +1,119 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageVolumeTransferAcceptV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeTransferAcceptV3Create,
+		Read:   resourceBlockStorageVolumeTransferAcceptV3Read,
+		Delete: resourceBlockStorageVolumeTransferAcceptV3Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"transfer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"auth_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			// Computed-only
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTransferAcceptV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	transferID := d.Get("transfer_id").(string)
+	acceptOpts := blockStorageVolumeTransferV3AcceptOpts{
+		AuthKey: d.Get("auth_key").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_volume_transfer_accept_v3 accepting transfer %s", transferID)
+	transfer, err := blockStorageVolumeTransferV3Accept(blockStorageClient, transferID, acceptOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_volume_transfer_accept_v3: %s", err)
+	}
+
+	// The transfer doesn't exist anymore once it is accepted.
+	// Use the ID of the transferred volume instead.
+	d.SetId(transfer.VolumeID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"awaiting-transfer"},
+		Target:     []string{"available"},
+		Refresh:    blockStorageVolumeV3StateRefreshFunc(blockStorageClient, transfer.VolumeID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for volume %s of openstack_blockstorage_volume_transfer_accept_v3 to become available: %s", transfer.VolumeID, err)
+	}
+
+	return resourceBlockStorageVolumeTransferAcceptV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTransferAcceptV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	v, err := volumes.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_volume_transfer_accept_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved volume of openstack_blockstorage_volume_transfer_accept_v3 %s: %#v", d.Id(), v)
+
+	d.Set("volume_id", v.ID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTransferAcceptV3Delete(d *schema.ResourceData, meta interface{}) error {
+	// An accepted transfer cannot be reverted.
+	// The volume stays in the project which accepted it.
+	log.Printf("[DEBUG] Removing openstack_blockstorage_volume_transfer_accept_v3 %s from state", d.Id())
+
+	return nil
+}
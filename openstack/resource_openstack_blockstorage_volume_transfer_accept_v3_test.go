@@ -0,0 +1,46 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBlockStorageV3VolumeTransferAccept_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTransferAccept_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_transfer_accept_v3.accept_1", "volume_id",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+				),
+				// The transfer is accepted by the project which created it,
+				// so the volume is still visible and the transfer is planned
+				// again.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+const testAccBlockStorageV3VolumeTransferAccept_basic = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_volume_transfer_v3" "transfer_1" {
+  name = "transfer_1"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+
+resource "openstack_blockstorage_volume_transfer_accept_v3" "accept_1" {
+  transfer_id = "${openstack_blockstorage_volume_transfer_v3.transfer_1.id}"
+  auth_key = "${openstack_blockstorage_volume_transfer_v3.transfer_1.auth_key}"
+}
+`
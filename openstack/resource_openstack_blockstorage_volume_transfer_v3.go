@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageVolumeTransferV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeTransferV3Create,
+		Read:   resourceBlockStorageVolumeTransferV3Read,
+		Delete: resourceBlockStorageVolumeTransferV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed-only
+			"auth_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTransferV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	createOpts := blockStorageVolumeTransferV3CreateOpts{
+		VolumeID: d.Get("volume_id").(string),
+		Name:     d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_volume_transfer_v3 create options: %#v", createOpts)
+
+	transfer, err := blockStorageVolumeTransferV3Create(blockStorageClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_volume_transfer_v3: %s", err)
+	}
+
+	d.SetId(transfer.ID)
+
+	// The authorization key is only returned on creation.
+	d.Set("auth_key", transfer.AuthKey)
+
+	return resourceBlockStorageVolumeTransferV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTransferV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	transfer, err := blockStorageVolumeTransferV3Get(blockStorageClient, d.Id())
+	if err != nil {
+		// The transfer doesn't exist anymore once it is accepted, and the
+		// volume then belongs to another project. Keep the transfer in that
+		// case, so that it isn't created again.
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			if volumeID := d.Get("volume_id").(string); volumeID != "" {
+				_, volumeErr := volumes.Get(blockStorageClient, volumeID).Extract()
+				if _, ok := volumeErr.(gophercloud.ErrDefault404); ok {
+					log.Printf("[DEBUG] openstack_blockstorage_volume_transfer_v3 %s was accepted", d.Id())
+					return nil
+				}
+			}
+		}
+
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_volume_transfer_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_volume_transfer_v3 %s: %#v", d.Id(), transfer)
+
+	d.Set("volume_id", transfer.VolumeID)
+	d.Set("name", transfer.Name)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTransferV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if err := blockStorageVolumeTransferV3Delete(blockStorageClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_blockstorage_volume_transfer_v3")
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBlockStorageV3VolumeTransfer_basic(t *testing.T) {
+	var transfer blockStorageVolumeTransferV3
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTransferDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTransfer_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTransferExists("openstack_blockstorage_volume_transfer_v3.transfer_1", &transfer),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_transfer_v3.transfer_1", "name", "transfer_1"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_blockstorage_volume_transfer_v3.transfer_1", "auth_key"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_transfer_v3.transfer_1", "volume_id",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeTransferDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_volume_transfer_v3" {
+			continue
+		}
+
+		_, err := blockStorageVolumeTransferV3Get(blockStorageClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Volume transfer still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3VolumeTransferExists(n string, transfer *blockStorageVolumeTransferV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := blockStorageVolumeTransferV3Get(blockStorageClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Volume transfer not found")
+		}
+
+		*transfer = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3VolumeTransfer_basic = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_volume_transfer_v3" "transfer_1" {
+  name = "transfer_1"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+`
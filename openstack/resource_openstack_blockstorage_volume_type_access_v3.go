@@ -0,0 +1,106 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageVolumeTypeAccessV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeTypeAccessV3Create,
+		Read:   resourceBlockStorageVolumeTypeAccessV3Read,
+		Delete: resourceBlockStorageVolumeTypeAccessV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"volume_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTypeAccessV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	volumeTypeID := d.Get("volume_type_id").(string)
+	projectID := d.Get("project_id").(string)
+
+	log.Printf("[DEBUG] openstack_blockstorage_volume_type_access_v3 adding project %s to volume type %s", projectID, volumeTypeID)
+	if err := blockStorageVolumeTypeV3AddAccess(blockStorageClient, volumeTypeID, projectID); err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_volume_type_access_v3: %s", err)
+	}
+
+	d.SetId(blockStorageVolumeTypeAccessV3ID(volumeTypeID, projectID))
+
+	return resourceBlockStorageVolumeTypeAccessV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTypeAccessV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	volumeTypeID, projectID, err := blockStorageVolumeTypeAccessV3ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	access, err := blockStorageVolumeTypeV3GetAccess(blockStorageClient, volumeTypeID, projectID)
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_volume_type_access_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_volume_type_access_v3 %s: %#v", d.Id(), access)
+
+	d.Set("volume_type_id", access.VolumeTypeID)
+	d.Set("project_id", access.ProjectID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTypeAccessV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	volumeTypeID, projectID, err := blockStorageVolumeTypeAccessV3ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = blockStorageVolumeTypeV3RemoveAccess(blockStorageClient, volumeTypeID, projectID)
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_blockstorage_volume_type_access_v3")
+	}
+
+	return nil
+}
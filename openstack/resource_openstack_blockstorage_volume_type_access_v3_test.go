@@ -0,0 +1,110 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBlockStorageV3VolumeTypeAccess_basic(t *testing.T) {
+	typeName := acctest.RandomWithPrefix("tf-acc-type")
+	projectName := acctest.RandomWithPrefix("tf-acc-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTypeAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeTypeAccess_basic(typeName, projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeAccessExists("openstack_blockstorage_volume_type_access_v3.access_1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_type_access_v3.access_1", "volume_type_id",
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_type_access_v3.access_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeTypeAccessDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_volume_type_access_v3" {
+			continue
+		}
+
+		volumeTypeID, projectID, err := blockStorageVolumeTypeAccessV3ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = blockStorageVolumeTypeV3GetAccess(blockStorageClient, volumeTypeID, projectID)
+		if err == nil {
+			return fmt.Errorf("Volume type access still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3VolumeTypeAccessExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		volumeTypeID, projectID, err := blockStorageVolumeTypeAccessV3ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = blockStorageVolumeTypeV3GetAccess(blockStorageClient, volumeTypeID, projectID)
+
+		return err
+	}
+}
+
+func testAccBlockStorageV3VolumeTypeAccess_basic(typeName, projectName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "%s"
+  is_public = false
+}
+
+resource "openstack_blockstorage_volume_type_access_v3" "access_1" {
+  volume_type_id = "${openstack_blockstorage_volume_type_v3.volume_type_1.id}"
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+}
+`, projectName, typeName)
+}
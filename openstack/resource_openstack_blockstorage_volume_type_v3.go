@@ -0,0 +1,190 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageVolumeTypeV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeTypeV3Create,
+		Read:   resourceBlockStorageVolumeTypeV3Read,
+		Update: resourceBlockStorageVolumeTypeV3Update,
+		Delete: resourceBlockStorageVolumeTypeV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"is_public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"extra_specs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			// Computed-only
+			"qos_specs_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTypeV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	isPublic := d.Get("is_public").(bool)
+	extraSpecs := d.Get("extra_specs").(map[string]interface{})
+	createOpts := volumetypes.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		IsPublic:    &isPublic,
+		ExtraSpecs:  expandToMapStringString(extraSpecs),
+	}
+
+	log.Printf("[DEBUG] openstack_blockstorage_volume_type_v3 create options: %#v", createOpts)
+	vt, err := volumetypes.Create(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_blockstorage_volume_type_v3: %s", err)
+	}
+
+	d.SetId(vt.ID)
+
+	return resourceBlockStorageVolumeTypeV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTypeV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	vt, err := volumetypes.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_volume_type_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_volume_type_v3 %s: %#v", d.Id(), vt)
+
+	d.Set("name", vt.Name)
+	d.Set("description", vt.Description)
+	d.Set("is_public", vt.IsPublic)
+	d.Set("qos_specs_id", vt.QosSpecID)
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("extra_specs", vt.ExtraSpecs); err != nil {
+		log.Printf("[WARN] Unable to set extra_specs for openstack_blockstorage_volume_type_v3 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTypeV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts volumetypes.UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("is_public") {
+		hasChange = true
+		isPublic := d.Get("is_public").(bool)
+		updateOpts.IsPublic = &isPublic
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_blockstorage_volume_type_v3 %s update options: %#v", d.Id(), updateOpts)
+		_, err = volumetypes.Update(blockStorageClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_blockstorage_volume_type_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("extra_specs") {
+		oldES, newES := d.GetChange("extra_specs")
+		newESRaw := newES.(map[string]interface{})
+
+		// Delete the removed extra specs.
+		for oldKey := range oldES.(map[string]interface{}) {
+			if _, ok := newESRaw[oldKey]; ok {
+				continue
+			}
+
+			if err := blockStorageVolumeTypeV3DeleteExtraSpec(blockStorageClient, d.Id(), oldKey); err != nil {
+				return fmt.Errorf("Error deleting extra_spec %s from openstack_blockstorage_volume_type_v3 %s: %s", oldKey, d.Id(), err)
+			}
+		}
+
+		// Add or update the other ones.
+		if len(newESRaw) > 0 {
+			extraSpecs := expandToMapStringString(newESRaw)
+
+			if err := blockStorageVolumeTypeV3SetExtraSpecs(blockStorageClient, d.Id(), extraSpecs); err != nil {
+				return fmt.Errorf("Error setting extra_specs for openstack_blockstorage_volume_type_v3 %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceBlockStorageVolumeTypeV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTypeV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	err = volumetypes.Delete(blockStorageClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_blockstorage_volume_type_v3")
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
+)
+
+func TestAccBlockStorageV3VolumeType_basic(t *testing.T) {
+	var volumeType volumetypes.VolumeType
+	typeName := acctest.RandomWithPrefix("tf-acc-type")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3VolumeType_basic(typeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "name", typeName),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "is_public", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.capabilities", "gpu"),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3VolumeType_update(typeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "name", typeName+"-updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "description", "ssd volumes"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "is_public", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.capabilities", "ssd"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeTypeDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_volume_type_v3" {
+			continue
+		}
+
+		_, err := volumetypes.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Volume type still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3VolumeTypeExists(n string, volumeType *volumetypes.VolumeType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := volumetypes.Get(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Volume type not found")
+		}
+
+		*volumeType = *found
+
+		return nil
+	}
+}
+
+func testAccBlockStorageV3VolumeType_basic(typeName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "%s"
+
+  extra_specs = {
+    capabilities = "gpu"
+    volume_backend_name = "lvmdriver-1"
+  }
+}
+`, typeName)
+}
+
+func testAccBlockStorageV3VolumeType_update(typeName string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "%s-updated"
+  description = "ssd volumes"
+  is_public = false
+
+  extra_specs = {
+    capabilities = "ssd"
+  }
+}
+`, typeName)
+}
@@ -12,8 +12,13 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
+// blockStorageVolumeV3BackupMicroversion is the minimum microversion which
+// supports creating a volume from a backup.
+const blockStorageVolumeV3BackupMicroversion = "3.47"
+
 func resourceBlockStorageVolumeV3() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBlockStorageVolumeV3Create,
@@ -26,6 +31,7 @@ func resourceBlockStorageVolumeV3() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -91,13 +97,27 @@ func resourceBlockStorageVolumeV3() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"backup_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_id", "source_vol_id", "image_id"},
+			},
+
 			"volume_type": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 
+			"migration_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"never", "on-demand",
+				}, false),
+			},
+
 			"consistency_group_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -163,15 +183,24 @@ func resourceBlockStorageVolumeV3Create(d *schema.ResourceData, meta interface{}
 		Multiattach:        d.Get("multiattach").(bool),
 	}
 
-	log.Printf("[DEBUG] openstack_blockstorage_volume_v3 create options: %#v", createOpts)
+	var opts volumes.CreateOptsBuilder = createOpts
+	if v := d.Get("backup_id").(string); v != "" {
+		blockStorageClient.Microversion = blockStorageVolumeV3BackupMicroversion
+		opts = VolumeV3BackupCreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			BackupID:          v,
+		}
+	}
 
-	v, err := volumes.Create(blockStorageClient, createOpts).Extract()
+	log.Printf("[DEBUG] openstack_blockstorage_volume_v3 create options: %#v", opts)
+
+	v, err := volumes.Create(blockStorageClient, opts).Extract()
 	if err != nil {
 		return fmt.Errorf("Error creating openstack_blockstorage_volume_v3: %s", err)
 	}
 
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"downloading", "creating"},
+		Pending:    []string{"downloading", "creating", "restoring-backup"},
 		Target:     []string{"available"},
 		Refresh:    blockStorageVolumeV3StateRefreshFunc(blockStorageClient, v.ID),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
@@ -288,6 +317,43 @@ func resourceBlockStorageVolumeV3Update(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	if d.HasChange("volume_type") {
+		retypeOpts := blockStorageVolumeV3RetypeOpts{
+			NewType:         d.Get("volume_type").(string),
+			MigrationPolicy: d.Get("migration_policy").(string),
+		}
+
+		log.Printf("[DEBUG] openstack_blockstorage_volume_v3 %s retype options: %#v", d.Id(), retypeOpts)
+		err = blockStorageVolumeV3Retype(blockStorageClient, d.Id(), retypeOpts)
+		if err != nil {
+			return fmt.Errorf("Error changing openstack_blockstorage_volume_v3 %s type: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"retyping"},
+			Target:     []string{"available", "in-use"},
+			Refresh:    blockStorageVolumeV3StateRefreshFunc(blockStorageClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		result, err := stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for openstack_blockstorage_volume_v3 %s to change type: %s", d.Id(), err)
+		}
+
+		// The scheduler rejects a retype asynchronously, for example when
+		// the volume needs to be migrated and the migration policy is "never".
+		// The volume is then back to its previous status and type.
+		if v := result.(*volumes.Volume); v.VolumeType != retypeOpts.NewType {
+			return fmt.Errorf(
+				"Error changing openstack_blockstorage_volume_v3 %s type: the volume is still of type %s, "+
+					"it may need to be migrated, see the migration_policy option", d.Id(), v.VolumeType)
+		}
+	}
+
 	_, err = volumes.Update(blockStorageClient, d.Id(), updateOpts).Extract()
 	if err != nil {
 		return fmt.Errorf("Error updating openstack_blockstorage_volume_v3 %s: %s", d.Id(), err)
@@ -2,13 +2,16 @@ package openstack
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumetypes"
 )
 
 func TestAccBlockStorageV3Volume_basic(t *testing.T) {
@@ -129,6 +132,111 @@ func TestAccBlockStorageV3Volume_timeout(t *testing.T) {
 	})
 }
 
+func TestAccBlockStorageV3Volume_retype(t *testing.T) {
+	var volume volumes.Volume
+	typeName1 := acctest.RandomWithPrefix("tf-acc-type")
+	typeName2 := acctest.RandomWithPrefix("tf-acc-type")
+
+	if os.Getenv("TF_ACC") != "" && os.Getenv("OS_USERNAME") == "admin" {
+		typeIDs, err := testAccBlockStorageV3CreateVolumeTypes(typeName1, typeName2)
+		defer testAccBlockStorageV3DeleteVolumeTypes(t, typeIDs)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3Volume_retype(typeName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "volume_type", typeName1),
+				),
+			},
+			{
+				Config: testAccBlockStorageV3Volume_retype(typeName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeSameID(&volume, "openstack_blockstorage_volume_v3.volume_1"),
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "volume_type", typeName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlockStorageV3CreateVolumeTypes(names ...string) ([]string, error) {
+	config, err := testAccAuthFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	bsClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return nil, err
+	}
+
+	var typeIDs []string
+	for _, name := range names {
+		createOpts := volumetypes.CreateOpts{
+			Name: name,
+		}
+
+		volumeType, err := volumetypes.Create(bsClient, createOpts).Extract()
+		if err != nil {
+			return typeIDs, err
+		}
+
+		typeIDs = append(typeIDs, volumeType.ID)
+	}
+
+	return typeIDs, nil
+}
+
+func testAccBlockStorageV3DeleteVolumeTypes(t *testing.T, typeIDs []string) {
+	config, err := testAccAuthFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bsClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range typeIDs {
+		if err := volumetypes.Delete(bsClient, id).ExtractErr(); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+// testAccCheckBlockStorageV3VolumeSameID checks that a volume was updated
+// in place instead of being replaced.
+func testAccCheckBlockStorageV3VolumeSameID(volume *volumes.Volume, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID != volume.ID {
+			return fmt.Errorf("Volume was replaced: %s != %s", rs.Primary.ID, volume.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckBlockStorageV3VolumeDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
@@ -316,3 +424,14 @@ resource "openstack_blockstorage_volume_v3" "volume_1" {
   }
 }
 `
+
+func testAccBlockStorageV3Volume_retype(volumeType string) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+  volume_type = "%s"
+  migration_policy = "on-demand"
+}
+`, volumeType)
+}
@@ -0,0 +1,251 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceComputeAggregateV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeAggregateV2Create,
+		Read:   resourceComputeAggregateV2Read,
+		Update: resourceComputeAggregateV2Update,
+		Delete: resourceComputeAggregateV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"hosts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceComputeAggregateV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	createOpts := aggregates.CreateOpts{
+		Name:             d.Get("name").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_compute_aggregate_v2 create options: %#v", createOpts)
+
+	aggregate, err := aggregates.Create(computeClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_compute_aggregate_v2: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(aggregate.ID))
+
+	metadata := d.Get("metadata").(map[string]interface{})
+	if len(metadata) > 0 {
+		setMetadataOpts := aggregates.SetMetadataOpts{
+			Metadata: metadata,
+		}
+
+		_, err = aggregates.SetMetadata(computeClient, aggregate.ID, setMetadataOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error setting metadata of openstack_compute_aggregate_v2 %d: %s", aggregate.ID, err)
+		}
+	}
+
+	for _, host := range d.Get("hosts").(*schema.Set).List() {
+		addHostOpts := aggregates.AddHostOpts{
+			Host: host.(string),
+		}
+
+		_, err = aggregates.AddHost(computeClient, aggregate.ID, addHostOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error adding host %s to openstack_compute_aggregate_v2 %d: %s", host, aggregate.ID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Created openstack_compute_aggregate_v2 %d: %#v", aggregate.ID, aggregate)
+
+	return resourceComputeAggregateV2Read(d, meta)
+}
+
+func resourceComputeAggregateV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	id, err := computeAggregateV2ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	aggregate, err := aggregates.Get(computeClient, id).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_compute_aggregate_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_aggregate_v2 %s: %#v", d.Id(), aggregate)
+
+	d.Set("name", aggregate.Name)
+	d.Set("availability_zone", aggregate.AvailabilityZone)
+	d.Set("metadata", flattenComputeAggregateV2Metadata(aggregate.Metadata))
+	d.Set("hosts", aggregate.Hosts)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceComputeAggregateV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	id, err := computeAggregateV2ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var hasChange bool
+	var updateOpts aggregates.UpdateOpts
+
+	if d.HasChange("name") {
+		hasChange = true
+		updateOpts.Name = d.Get("name").(string)
+	}
+
+	availabilityZone := d.Get("availability_zone").(string)
+	if d.HasChange("availability_zone") && availabilityZone != "" {
+		hasChange = true
+		updateOpts.AvailabilityZone = availabilityZone
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_compute_aggregate_v2 %s update options: %#v", d.Id(), updateOpts)
+
+		_, err = aggregates.Update(computeClient, id, updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_compute_aggregate_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("metadata") || (d.HasChange("availability_zone") && availabilityZone == "") {
+		oldMetadata, newMetadata := d.GetChange("metadata")
+		metadata := expandComputeAggregateV2Metadata(oldMetadata.(map[string]interface{}), newMetadata.(map[string]interface{}))
+
+		// The availability zone can't be removed with an update, since an
+		// empty value is omitted. It's stored as metadata instead.
+		if d.HasChange("availability_zone") && availabilityZone == "" {
+			metadata["availability_zone"] = nil
+		}
+
+		setMetadataOpts := aggregates.SetMetadataOpts{
+			Metadata: metadata,
+		}
+
+		_, err = aggregates.SetMetadata(computeClient, id, setMetadataOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error setting metadata of openstack_compute_aggregate_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("hosts") {
+		oldHosts, newHosts := d.GetChange("hosts")
+
+		for _, host := range oldHosts.(*schema.Set).Difference(newHosts.(*schema.Set)).List() {
+			removeHostOpts := aggregates.RemoveHostOpts{
+				Host: host.(string),
+			}
+
+			_, err = aggregates.RemoveHost(computeClient, id, removeHostOpts).Extract()
+			if err != nil {
+				return fmt.Errorf("Error removing host %s from openstack_compute_aggregate_v2 %s: %s", host, d.Id(), err)
+			}
+		}
+
+		for _, host := range newHosts.(*schema.Set).Difference(oldHosts.(*schema.Set)).List() {
+			addHostOpts := aggregates.AddHostOpts{
+				Host: host.(string),
+			}
+
+			_, err = aggregates.AddHost(computeClient, id, addHostOpts).Extract()
+			if err != nil {
+				return fmt.Errorf("Error adding host %s to openstack_compute_aggregate_v2 %s: %s", host, d.Id(), err)
+			}
+		}
+	}
+
+	return resourceComputeAggregateV2Read(d, meta)
+}
+
+func resourceComputeAggregateV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	id, err := computeAggregateV2ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	aggregate, err := aggregates.Get(computeClient, id).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_compute_aggregate_v2")
+	}
+
+	// An aggregate can only be deleted, if it doesn't contain any hosts.
+	for _, host := range aggregate.Hosts {
+		removeHostOpts := aggregates.RemoveHostOpts{
+			Host: host,
+		}
+
+		_, err = aggregates.RemoveHost(computeClient, id, removeHostOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error removing host %s from openstack_compute_aggregate_v2 %s: %s", host, d.Id(), err)
+		}
+	}
+
+	err = aggregates.Delete(computeClient, id).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_compute_aggregate_v2")
+	}
+
+	return nil
+}
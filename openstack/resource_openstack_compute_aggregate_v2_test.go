@@ -0,0 +1,137 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeV2Aggregate_basic(t *testing.T) {
+	var aggregate aggregates.Aggregate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2AggregateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2Aggregate_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2AggregateExists("openstack_compute_aggregate_v2.aggregate_1", &aggregate),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_aggregate_v2.aggregate_1", "name", "aggregate_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_aggregate_v2.aggregate_1", "availability_zone", "az_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_aggregate_v2.aggregate_1", "metadata.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_aggregate_v2.aggregate_1", "metadata.ssd", "true"),
+				),
+			},
+			{
+				Config: testAccComputeV2Aggregate_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2AggregateExists("openstack_compute_aggregate_v2.aggregate_1", &aggregate),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_aggregate_v2.aggregate_1", "name", "aggregate_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_aggregate_v2.aggregate_1", "availability_zone", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_aggregate_v2.aggregate_1", "metadata.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_aggregate_v2.aggregate_1", "metadata.gpu", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2AggregateDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	computeClient, err := config.computeV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_compute_aggregate_v2" {
+			continue
+		}
+
+		id, err := computeAggregateV2ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = aggregates.Get(computeClient, id).Extract()
+		if err == nil {
+			return fmt.Errorf("Aggregate still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComputeV2AggregateExists(n string, aggregate *aggregates.Aggregate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		computeClient, err := config.computeV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+		}
+
+		id, err := computeAggregateV2ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := aggregates.Get(computeClient, id).Extract()
+		if err != nil {
+			return err
+		}
+
+		if fmt.Sprintf("%d", found.ID) != rs.Primary.ID {
+			return fmt.Errorf("Aggregate not found")
+		}
+
+		*aggregate = *found
+
+		return nil
+	}
+}
+
+const testAccComputeV2Aggregate_basic = `
+resource "openstack_compute_aggregate_v2" "aggregate_1" {
+  name = "aggregate_1"
+  availability_zone = "az_1"
+
+  metadata = {
+    ssd = "true"
+  }
+}
+`
+
+const testAccComputeV2Aggregate_update = `
+resource "openstack_compute_aggregate_v2" "aggregate_1" {
+  name = "aggregate_1_updated"
+
+  metadata = {
+    gpu = "true"
+  }
+}
+`
@@ -7,19 +7,23 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/bootfromvolume"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/schedulerhints"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/secgroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/startstop"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -28,10 +32,14 @@ import (
 
 func resourceComputeInstanceV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceComputeInstanceV2Create,
-		Read:   resourceComputeInstanceV2Read,
-		Update: resourceComputeInstanceV2Update,
-		Delete: resourceComputeInstanceV2Delete,
+		Create:        resourceComputeInstanceV2Create,
+		Read:          resourceComputeInstanceV2Read,
+		Update:        resourceComputeInstanceV2Update,
+		Delete:        resourceComputeInstanceV2Delete,
+		CustomizeDiff: resourceComputeInstanceV2CustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeInstanceV2Import,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -52,16 +60,17 @@ func resourceComputeInstanceV2() *schema.Resource {
 				Required: true,
 				ForceNew: false,
 			},
+			// A change of the image forces a new instance, unless
+			// vendor_options.rebuild_on_image_change is set. See
+			// resourceComputeInstanceV2CustomizeDiff.
 			"image_id": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 			"image_name": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 			"flavor_id": {
@@ -278,8 +287,9 @@ func resourceComputeInstanceV2() *schema.Resource {
 				},
 			},
 			"scheduler_hints": {
-				Type:     schema.TypeSet,
-				Optional: true,
+				Type:             schema.TypeSet,
+				Optional:         true,
+				DiffSuppressFunc: computeInstanceV2ImportedSchedulerHintsDiffSuppressFunc,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"group": {
@@ -378,6 +388,26 @@ func resourceComputeInstanceV2() *schema.Resource {
 							Default:  false,
 							Optional: true,
 						},
+						"resize_confirm": {
+							Type:     schema.TypeBool,
+							Default:  true,
+							Optional: true,
+						},
+						"resize_revert_on_failure": {
+							Type:     schema.TypeBool,
+							Default:  false,
+							Optional: true,
+						},
+						"resize_timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateComputeInstanceV2Duration,
+						},
+						"rebuild_on_image_change": {
+							Type:     schema.TypeBool,
+							Default:  false,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -792,15 +822,67 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("flavor_id") || d.HasChange("flavor_name") {
-		// Get vendor_options
-		vendorOptionsRaw := d.Get("vendor_options").(*schema.Set)
-		var ignoreResizeConfirmation bool
-		if vendorOptionsRaw.Len() > 0 {
-			vendorOptions := expandVendorOptions(vendorOptionsRaw.List())
-			ignoreResizeConfirmation = vendorOptions["ignore_resize_confirmation"].(bool)
+	// Get vendor_options
+	vendorOptionsRaw := d.Get("vendor_options").(*schema.Set)
+	var ignoreResizeConfirmation, resizeRevertOnFailure bool
+	resizeConfirm := true
+	resizeTimeout := d.Timeout(schema.TimeoutUpdate)
+	if vendorOptionsRaw.Len() > 0 {
+		vendorOptions := expandVendorOptions(vendorOptionsRaw.List())
+		ignoreResizeConfirmation = vendorOptions["ignore_resize_confirmation"].(bool)
+		resizeConfirm = vendorOptions["resize_confirm"].(bool)
+		resizeRevertOnFailure = vendorOptions["resize_revert_on_failure"].(bool)
+		if v, ok := vendorOptions["resize_timeout"].(string); ok && v != "" {
+			resizeTimeout, _ = time.ParseDuration(v)
+		}
+	}
+
+	// An image change only reaches Update when rebuild_on_image_change is set.
+	// Otherwise resourceComputeInstanceV2CustomizeDiff forces a new instance.
+	if d.HasChange("image_id") || d.HasChange("image_name") {
+		var newImageID string
+		if v := d.Get("image_id").(string); d.HasChange("image_id") && v != "" {
+			newImageID = v
+		} else {
+			newImageID, err = images.IDFromName(computeClient, d.Get("image_name").(string))
+			if err != nil {
+				return err
+			}
+		}
+
+		rebuildOpts := &servers.RebuildOpts{
+			ImageID:  newImageID,
+			Metadata: resourceInstanceMetadataV2(d),
+		}
+		log.Printf("[DEBUG] Rebuild configuration: %#v", rebuildOpts)
+
+		// Admin password is set after logging the options.
+		rebuildOpts.AdminPass = d.Get("admin_pass").(string)
+
+		_, err = servers.Rebuild(computeClient, d.Id(), rebuildOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error rebuilding OpenStack server: %s", err)
+		}
+
+		// Wait for the instance to finish rebuilding.
+		log.Printf("[DEBUG] Waiting for instance (%s) to finish rebuilding", d.Id())
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"REBUILD"},
+			Target:     []string{"ACTIVE", "SHUTOFF"},
+			Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
 		}
 
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for instance (%s) to rebuild: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("flavor_id") || d.HasChange("flavor_name") {
 		var newFlavorId string
 		var err error
 		if d.HasChange("flavor_id") {
@@ -831,7 +913,7 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 				Pending:    []string{"RESIZE", "VERIFY_RESIZE"},
 				Target:     []string{"ACTIVE", "SHUTOFF"},
 				Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				Timeout:    resizeTimeout,
 				Delay:      10 * time.Second,
 				MinTimeout: 3 * time.Second,
 			}
@@ -845,35 +927,47 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 				Pending:    []string{"RESIZE"},
 				Target:     []string{"VERIFY_RESIZE"},
 				Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				Timeout:    resizeTimeout,
 				Delay:      10 * time.Second,
 				MinTimeout: 3 * time.Second,
 			}
 
 			_, err = stateConf.WaitForState()
 			if err != nil {
-				return fmt.Errorf("Error waiting for instance (%s) to resize: %s", d.Id(), err)
+				err = fmt.Errorf("Error waiting for instance (%s) to resize: %s", d.Id(), err)
+				if resizeRevertOnFailure {
+					return computeInstanceV2RevertResize(computeClient, d, err)
+				}
+				return err
 			}
 
-			// Confirm resize.
-			log.Printf("[DEBUG] Confirming resize")
-			err = servers.ConfirmResize(computeClient, d.Id()).ExtractErr()
-			if err != nil {
-				return fmt.Errorf("Error confirming resize of OpenStack server: %s", err)
-			}
+			// Confirm resize, unless the user confirms or reverts it manually.
+			if resizeConfirm {
+				log.Printf("[DEBUG] Confirming resize")
+				err = servers.ConfirmResize(computeClient, d.Id()).ExtractErr()
+				if err != nil {
+					err = fmt.Errorf("Error confirming resize of OpenStack server: %s", err)
+					if resizeRevertOnFailure {
+						return computeInstanceV2RevertResize(computeClient, d, err)
+					}
+					return err
+				}
 
-			stateConf = &resource.StateChangeConf{
-				Pending:    []string{"VERIFY_RESIZE"},
-				Target:     []string{"ACTIVE", "SHUTOFF"},
-				Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
-			}
+				stateConf = &resource.StateChangeConf{
+					Pending:    []string{"VERIFY_RESIZE"},
+					Target:     []string{"ACTIVE", "SHUTOFF"},
+					Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
+					Timeout:    resizeTimeout,
+					Delay:      10 * time.Second,
+					MinTimeout: 3 * time.Second,
+				}
 
-			_, err = stateConf.WaitForState()
-			if err != nil {
-				return fmt.Errorf("Error waiting for instance (%s) to confirm resize: %s", d.Id(), err)
+				_, err = stateConf.WaitForState()
+				if err != nil {
+					return fmt.Errorf("Error waiting for instance (%s) to confirm resize: %s", d.Id(), err)
+				}
+			} else {
+				log.Printf("[DEBUG] Leaving the resize of instance (%s) unconfirmed", d.Id())
 			}
 		}
 	}
@@ -945,6 +1039,240 @@ func resourceComputeInstanceV2Delete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func resourceComputeInstanceV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	// delete_on_termination is only returned for the attached volumes
+	// starting with microversion 2.3, as is the root device name.
+	computeClient.Microversion = "2.3"
+
+	var server struct {
+		Image           interface{}              `json:"image"`
+		RootDeviceName  string                   `json:"OS-EXT-SRV-ATTR:root_device_name"`
+		KeyName         string                   `json:"key_name"`
+		ConfigDrive     string                   `json:"config_drive"`
+		Metadata        map[string]string        `json:"metadata"`
+		VolumesAttached []map[string]interface{} `json:"os-extended-volumes:volumes_attached"`
+	}
+
+	err = servers.Get(computeClient, d.Id()).ExtractInto(&server)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving openstack_compute_instance_v2 %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_instance_v2 %s for import: %#v", d.Id(), server)
+
+	// An instance booted from a volume has an empty image. In that case,
+	// the block_device needs to be set before the instance is read so that
+	// the image information is handled the same way as during creation.
+	if image, ok := server.Image.(map[string]interface{}); !ok || image["id"] == nil {
+		blockDevices, err := computeInstanceV2ImportBlockDevices(d, meta, server.RootDeviceName, server.VolumesAttached)
+		if err != nil {
+			return nil, err
+		}
+		d.Set("block_device", blockDevices)
+	}
+
+	d.Set("key_pair", server.KeyName)
+	d.Set("config_drive", strings.ToLower(server.ConfigDrive) == "true")
+	d.Set("metadata", server.Metadata)
+	d.Set("stop_before_destroy", false)
+	d.Set("force_delete", false)
+
+	schedulerHints, err := computeInstanceV2ImportSchedulerHints(computeClient, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("scheduler_hints", schedulerHints)
+
+	if err := resourceComputeInstanceV2Read(d, meta); err != nil {
+		return nil, err
+	}
+
+	// The network blocks were built from the instance addresses. Add the
+	// ports to them, so that the instance can be refreshed the same way as
+	// if the ports were specified in the configuration.
+	networks, err := computeInstanceV2ImportNetworkPorts(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("network", networks)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// computeInstanceV2ImportBlockDevices rebuilds the block_device of an
+// instance booted from a volume. Only bootable volumes are considered since
+// other volumes are usually managed by openstack_compute_volume_attach_v2.
+// The volume attached as the root device gets a boot_index of 0 and is listed
+// first, the other volumes follow in the order of their device names with a
+// boot_index of -1.
+func computeInstanceV2ImportBlockDevices(d *schema.ResourceData, meta interface{}, rootDeviceName string, attachments []map[string]interface{}) ([]map[string]interface{}, error) {
+	blockDevices := []map[string]interface{}{}
+	if len(attachments) == 0 {
+		return blockDevices, nil
+	}
+
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	devices := map[string]map[string]interface{}{}
+	var deviceNames []string
+
+	for _, attachment := range attachments {
+		volumeID, ok := attachment["id"].(string)
+		if !ok {
+			continue
+		}
+
+		volume, err := volumes.Get(blockStorageClient, volumeID).Extract()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving volume %s of openstack_compute_instance_v2 %s: %s", volumeID, d.Id(), err)
+		}
+
+		if volume.Bootable != "true" {
+			continue
+		}
+
+		deleteOnTermination, _ := attachment["delete_on_termination"].(bool)
+
+		blockDevice := map[string]interface{}{
+			"source_type":           "volume",
+			"uuid":                  volume.ID,
+			"destination_type":      "volume",
+			"boot_index":            -1,
+			"delete_on_termination": deleteOnTermination,
+		}
+
+		// A volume created by Nova from an image keeps the image
+		// information in its metadata.
+		if imageID := volume.VolumeImageMetadata["image_id"]; imageID != "" {
+			blockDevice["source_type"] = "image"
+			blockDevice["uuid"] = imageID
+			blockDevice["volume_size"] = volume.Size
+		}
+
+		// The volume ID keeps the volumes apart, in case Cinder doesn't know
+		// the device name of an attachment.
+		deviceName := volume.ID
+		for _, v := range volume.Attachments {
+			if v.ServerID == d.Id() && v.Device != "" {
+				deviceName = v.Device
+				break
+			}
+		}
+
+		devices[deviceName] = blockDevice
+		deviceNames = append(deviceNames, deviceName)
+	}
+
+	if len(deviceNames) == 0 {
+		return blockDevices, nil
+	}
+
+	sort.Strings(deviceNames)
+
+	// The root device name is only returned to admins by default. Nova names
+	// the devices in the order of their boot index, so the lowest device name
+	// belongs to the root device otherwise.
+	if _, ok := devices[rootDeviceName]; !ok {
+		log.Printf("[DEBUG] Unable to find the root device %q of openstack_compute_instance_v2 %s, using %q", rootDeviceName, d.Id(), deviceNames[0])
+		rootDeviceName = deviceNames[0]
+	}
+
+	devices[rootDeviceName]["boot_index"] = 0
+	blockDevices = append(blockDevices, devices[rootDeviceName])
+
+	for _, deviceName := range deviceNames {
+		if deviceName != rootDeviceName {
+			blockDevices = append(blockDevices, devices[deviceName])
+		}
+	}
+
+	log.Printf("[DEBUG] Imported openstack_compute_instance_v2 %s block devices: %#v", d.Id(), blockDevices)
+
+	return blockDevices, nil
+}
+
+// computeInstanceV2ImportSchedulerHints discovers the scheduler hints of an
+// instance. Only the server group can be discovered since Nova does not
+// return any other hints.
+func computeInstanceV2ImportSchedulerHints(computeClient *gophercloud.ServiceClient, instanceID string) ([]map[string]interface{}, error) {
+	schedulerHints := []map[string]interface{}{}
+
+	allPages, err := servergroups.List(computeClient).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to query server groups for openstack_compute_instance_v2 %s: %s", instanceID, err)
+	}
+
+	allServerGroups, err := servergroups.ExtractServerGroups(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve server groups for openstack_compute_instance_v2 %s: %s", instanceID, err)
+	}
+
+	for _, sg := range allServerGroups {
+		if !strSliceContains(sg.Members, instanceID) {
+			continue
+		}
+
+		schedulerHints = append(schedulerHints, map[string]interface{}{
+			"group":                 sg.ID,
+			"different_host":        []interface{}{},
+			"same_host":             []interface{}{},
+			"query":                 []interface{}{},
+			"target_cell":           "",
+			"build_near_host_ip":    "",
+			"additional_properties": map[string]interface{}{},
+		})
+		break
+	}
+
+	return schedulerHints, nil
+}
+
+// computeInstanceV2ImportNetworkPorts looks up the Neutron port of every
+// network of an instance by its MAC address.
+func computeInstanceV2ImportNetworkPorts(d *schema.ResourceData, meta interface{}) ([]interface{}, error) {
+	networks := d.Get("network").([]interface{})
+
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		log.Printf("[DEBUG] Unable to obtain a network client, skipping port lookup for openstack_compute_instance_v2 %s", d.Id())
+		return networks, nil
+	}
+
+	allPages, err := ports.List(networkingClient, ports.ListOpts{DeviceID: d.Id()}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to query ports for openstack_compute_instance_v2 %s: %s", d.Id(), err)
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve ports for openstack_compute_instance_v2 %s: %s", d.Id(), err)
+	}
+
+	for _, v := range networks {
+		network := v.(map[string]interface{})
+		for _, port := range allPorts {
+			if port.MACAddress == network["mac"] {
+				network["port"] = port.ID
+				network["uuid"] = port.NetworkID
+				break
+			}
+		}
+	}
+
+	return networks, nil
+}
+
 // ServerV2StateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // an OpenStack instance.
 func ServerV2StateRefreshFunc(client *gophercloud.ServiceClient, instanceID string) resource.StateRefreshFunc {
@@ -1117,7 +1445,8 @@ func setImageInformation(computeClient *gophercloud.ServiceClient, server *serve
 		}
 	}
 
-	imageId := server.Image["id"].(string)
+	// An instance booted from a volume has no image information at all.
+	imageId, _ := server.Image["id"].(string)
 	if imageId != "" {
 		d.Set("image_id", imageId)
 		if image, err := images.Get(computeClient, imageId).Extract(); err != nil {
@@ -385,6 +385,42 @@ func TestAccComputeV2Instance_changeFixedIP(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_rebuild(t *testing.T) {
+	var instance1_1 servers.Server
+	var instance1_2 servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2Instance_rebuild_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(
+						"openstack_compute_instance_v2.instance_1", &instance1_1),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "image_id", OS_IMAGE_ID),
+				),
+			},
+			{
+				Config: testAccComputeV2Instance_rebuild_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(
+						"openstack_compute_instance_v2.instance_1", &instance1_2),
+					testAccCheckComputeV2InstanceInstanceIDsMatch(&instance1_1, &instance1_2),
+					testAccCheckComputeV2InstanceMetadata(&instance1_2, "foo", "bar"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_compute_instance_v2.instance_1", "image_id",
+						"openstack_images_image_v2.image_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "network.0.fixed_ip_v4", "192.168.199.24"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2Instance_stopBeforeDestroy(t *testing.T) {
 	var instance servers.Server
 	resource.Test(t, resource.TestCase{
@@ -731,6 +767,17 @@ func testAccCheckComputeV2InstanceInstanceIDsDoNotMatch(
 	}
 }
 
+func testAccCheckComputeV2InstanceInstanceIDsMatch(
+	instance1, instance2 *servers.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if instance1.ID != instance2.ID {
+			return fmt.Errorf("Instance was recreated.")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckComputeV2InstanceState(
 	instance *servers.Server, state string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -1341,3 +1388,76 @@ resource "openstack_compute_instance_v2" "instance_1" {
   }
 }
 `, OS_NETWORK_ID)
+
+var testAccComputeV2Instance_rebuild_1 = fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  depends_on = ["openstack_networking_subnet_v2.subnet_1"]
+
+  name = "instance_1"
+  image_id = "%s"
+  security_groups = ["default"]
+  metadata = {
+    foo = "bar"
+  }
+
+  network {
+    uuid = "${openstack_networking_network_v2.network_1.id}"
+    fixed_ip_v4 = "192.168.199.24"
+  }
+
+  vendor_options {
+    rebuild_on_image_change = true
+  }
+}
+`, OS_IMAGE_ID)
+
+const testAccComputeV2Instance_rebuild_2 = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+}
+
+resource "openstack_images_image_v2" "image_1" {
+  name = "CirrOS-tf_1"
+  container_format = "bare"
+  disk_format = "qcow2"
+  image_source_url = "http://download.cirros-cloud.net/0.3.5/cirros-0.3.5-x86_64-disk.img"
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  depends_on = ["openstack_networking_subnet_v2.subnet_1"]
+
+  name = "instance_1"
+  image_id = "${openstack_images_image_v2.image_1.id}"
+  security_groups = ["default"]
+  metadata = {
+    foo = "bar"
+  }
+
+  network {
+    uuid = "${openstack_networking_network_v2.network_1.id}"
+    fixed_ip_v4 = "192.168.199.24"
+  }
+
+  vendor_options {
+    rebuild_on_image_change = true
+  }
+}
+`
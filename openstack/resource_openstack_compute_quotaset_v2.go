@@ -0,0 +1,203 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/quotasets"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceComputeQuotasetV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeQuotasetV2Create,
+		Read:   resourceComputeQuotasetV2Read,
+		Update: resourceComputeQuotasetV2Update,
+		Delete: resourceComputeQuotasetV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"fixed_ips": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"floating_ips": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"injected_file_content_bytes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"injected_file_path_bytes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"injected_files": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"key_pairs": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"metadata_items": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"ram": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"security_group_rules": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"security_groups": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"cores": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"instances": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"server_groups": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"server_group_members": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeQuotasetV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	updateOpts := expandComputeQuotasetV2UpdateOpts(d)
+
+	log.Printf("[DEBUG] openstack_compute_quotaset_v2 %s create options: %#v", projectID, updateOpts)
+
+	_, err = quotasets.Update(computeClient, projectID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_compute_quotaset_v2 %s: %s", projectID, err)
+	}
+
+	d.SetId(projectID)
+
+	log.Printf("[DEBUG] Created openstack_compute_quotaset_v2 %s", projectID)
+
+	return resourceComputeQuotasetV2Read(d, meta)
+}
+
+func resourceComputeQuotasetV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	q, err := quotasets.Get(computeClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_compute_quotaset_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_quotaset_v2 %s: %#v", d.Id(), q)
+
+	for k, v := range flattenComputeQuotasetV2(q) {
+		d.Set(k, v)
+	}
+
+	d.Set("project_id", d.Id())
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceComputeQuotasetV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	updateOpts := expandComputeQuotasetV2UpdateOpts(d)
+
+	log.Printf("[DEBUG] openstack_compute_quotaset_v2 %s update options: %#v", d.Id(), updateOpts)
+
+	_, err = quotasets.Update(computeClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_compute_quotaset_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceComputeQuotasetV2Read(d, meta)
+}
+
+// resourceComputeQuotasetV2Delete resets the quotas of the project to the
+// defaults.
+func resourceComputeQuotasetV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	if err := quotasets.Delete(computeClient, d.Id()).Err; err != nil {
+		return CheckDeleted(d, err, "Error resetting openstack_compute_quotaset_v2")
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/quotasets"
+)
+
+func TestAccComputeV2QuotasetBasic(t *testing.T) {
+	var quota quotasets.QuotaSet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2QuotasetBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2QuotasetExists("openstack_compute_quotaset_v2.quotaset_1", &quota),
+					resource.TestCheckResourceAttrPair(
+						"openstack_compute_quotaset_v2.quotaset_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "key_pairs", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "ram", "40960"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "cores", "32"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "instances", "20"),
+				),
+			},
+			{
+				Config: testAccComputeV2QuotasetUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2QuotasetExists("openstack_compute_quotaset_v2.quotaset_1", &quota),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "key_pairs", "5"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "ram", "20480"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "cores", "16"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "instances", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2QuotasetExists(n string, quota *quotasets.QuotaSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		computeClient, err := config.computeV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+		}
+
+		found, err := quotasets.Get(computeClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		*quota = *found
+
+		return nil
+	}
+}
+
+const testAccComputeV2QuotasetBasic = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_compute_quotaset_v2" "quotaset_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  key_pairs  = 10
+  ram        = 40960
+  cores      = 32
+  instances  = 20
+}
+`
+
+const testAccComputeV2QuotasetUpdate = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_compute_quotaset_v2" "quotaset_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  key_pairs  = 5
+  ram        = 20480
+  cores      = 16
+  instances  = 0
+}
+`
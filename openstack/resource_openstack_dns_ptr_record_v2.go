@@ -0,0 +1,221 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceDNSPTRRecordV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSPTRRecordV2Create,
+		Read:   resourceDNSPTRRecordV2Read,
+		Update: resourceDNSPTRRecordV2Update,
+		Delete: resourceDNSPTRRecordV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDNSPTRRecordV2Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"floatingip_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ptrdname": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSPTRRecordV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	dnsClient, err := config.dnsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	if region == "" {
+		return fmt.Errorf("Error creating openstack_dns_ptr_record_v2: region must be set")
+	}
+
+	id := dnsPTRRecordV2ID(region, d.Get("floatingip_id").(string))
+	description := d.Get("description").(string)
+	setOpts := dnsPTRRecordV2SetOpts{
+		PTRDName:    d.Get("ptrdname").(string),
+		Description: &description,
+		TTL:         d.Get("ttl").(int),
+	}
+
+	log.Printf("[DEBUG] openstack_dns_ptr_record_v2 %s create options: %#v", id, setOpts)
+	_, err = dnsPTRRecordV2Set(dnsClient, id, setOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_dns_ptr_record_v2 %s: %s", id, err)
+	}
+
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Waiting for openstack_dns_ptr_record_v2 %s to become available", id)
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    dnsPTRRecordV2RefreshFunc(dnsClient, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_dns_ptr_record_v2 %s to become available: %s", id, err)
+	}
+
+	return resourceDNSPTRRecordV2Read(d, meta)
+}
+
+func resourceDNSPTRRecordV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	ptr, err := dnsPTRRecordV2Get(dnsClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_dns_ptr_record_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_ptr_record_v2 %s: %#v", d.Id(), ptr)
+
+	// The floating IP exists, but has no PTR record anymore.
+	if ptr.PTRDName == "" {
+		log.Printf("[DEBUG] openstack_dns_ptr_record_v2 %s has no ptrdname, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	region, floatingIPID, err := dnsPTRRecordV2ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("floatingip_id", floatingIPID)
+	d.Set("ptrdname", ptr.PTRDName)
+	d.Set("description", ptr.Description)
+	d.Set("ttl", ptr.TTL)
+	d.Set("address", ptr.Address)
+	d.Set("region", region)
+
+	return nil
+}
+
+func resourceDNSPTRRecordV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	// ptrdname is always required by Designate.
+	setOpts := dnsPTRRecordV2SetOpts{
+		PTRDName: d.Get("ptrdname").(string),
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		setOpts.Description = &description
+	}
+
+	if d.HasChange("ttl") {
+		setOpts.TTL = d.Get("ttl").(int)
+	}
+
+	log.Printf("[DEBUG] Updating openstack_dns_ptr_record_v2 %s with options: %#v", d.Id(), setOpts)
+	_, err = dnsPTRRecordV2Set(dnsClient, d.Id(), setOpts)
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_dns_ptr_record_v2 %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    dnsPTRRecordV2RefreshFunc(dnsClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_dns_ptr_record_v2 %s to become available: %s", d.Id(), err)
+	}
+
+	return resourceDNSPTRRecordV2Read(d, meta)
+}
+
+func resourceDNSPTRRecordV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	err = dnsPTRRecordV2Unset(dnsClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_dns_ptr_record_v2")
+	}
+
+	return nil
+}
+
+func resourceDNSPTRRecordV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	region, floatingIPID, err := dnsPTRRecordV2ParseID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("region", region)
+	d.Set("floatingip_id", floatingIPID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,93 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDNSV2PTRRecord_basic(t *testing.T) {
+	ptrName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2PTRRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2PTRRecord_basic(ptrName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "ptrdname", ptrName),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "description", "a ptr record"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "ttl", "3000"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_ptr_record_v2.ptr_1", "address",
+						"openstack_networking_floatingip_v2.fip_1", "address"),
+				),
+			},
+			{
+				Config: testAccDNSV2PTRRecord_update(ptrName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "description", "an updated ptr record"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptr_record_v2.ptr_1", "ttl", "6000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2PTRRecordDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_dns_ptr_record_v2" {
+			continue
+		}
+
+		ptr, err := dnsPTRRecordV2Get(dnsClient, rs.Primary.ID)
+		if err == nil && ptr.PTRDName != "" {
+			return fmt.Errorf("PTR record still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccDNSV2PTRRecord_basic(ptrName string) string {
+	return fmt.Sprintf(`
+		resource "openstack_networking_floatingip_v2" "fip_1" {
+		}
+
+		resource "openstack_dns_ptr_record_v2" "ptr_1" {
+			floatingip_id = "${openstack_networking_floatingip_v2.fip_1.id}"
+			ptrdname = "%s"
+			description = "a ptr record"
+			ttl = 3000
+		}
+	`, ptrName)
+}
+
+func testAccDNSV2PTRRecord_update(ptrName string) string {
+	return fmt.Sprintf(`
+		resource "openstack_networking_floatingip_v2" "fip_1" {
+		}
+
+		resource "openstack_dns_ptr_record_v2" "ptr_1" {
+			floatingip_id = "${openstack_networking_floatingip_v2.fip_1.id}"
+			ptrdname = "%s"
+			description = "an updated ptr record"
+			ttl = 6000
+		}
+	`, ptrName)
+}
@@ -0,0 +1,128 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDNSTransferAcceptV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSTransferAcceptV2Create,
+		Read:   resourceDNSTransferAcceptV2Read,
+		Delete: resourceDNSTransferAcceptV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"zone_transfer_request_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSTransferAcceptV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	createOpts := dnsTransferAcceptV2CreateOpts{
+		ZoneTransferRequestID: d.Get("zone_transfer_request_id").(string),
+		Key:                   d.Get("key").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_dns_transfer_accept_v2 accepting transfer request %s", createOpts.ZoneTransferRequestID)
+	n, err := dnsTransferAcceptV2Create(dnsClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_dns_transfer_accept_v2: %s", err)
+	}
+
+	d.SetId(n.ID)
+
+	log.Printf("[DEBUG] Waiting for openstack_dns_transfer_accept_v2 %s to complete", n.ID)
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"COMPLETE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    dnsTransferAcceptV2RefreshFunc(dnsClient, n.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_dns_transfer_accept_v2 %s to complete: %s", n.ID, err)
+	}
+
+	return resourceDNSTransferAcceptV2Read(d, meta)
+}
+
+func resourceDNSTransferAcceptV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	n, err := dnsTransferAcceptV2Get(dnsClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_dns_transfer_accept_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_transfer_accept_v2 %s for zone %s with status %s", d.Id(), n.ZoneID, n.Status)
+
+	d.Set("zone_transfer_request_id", n.ZoneTransferRequestID)
+	d.Set("zone_id", n.ZoneID)
+	d.Set("project_id", n.ProjectID)
+	d.Set("status", n.Status)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceDNSTransferAcceptV2Delete(d *schema.ResourceData, meta interface{}) error {
+	// A zone transfer cannot be undone, and its acceptance cannot be
+	// deleted. The zone stays in the project that accepted it.
+	log.Printf("[DEBUG] Removing openstack_dns_transfer_accept_v2 %s from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDNSV2TransferAccept_basic(t *testing.T) {
+	var zoneName = fmt.Sprintf("ACPTTEST%s.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2TransferAccept_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_transfer_accept_v2.accept_1", "zone_id",
+						"openstack_dns_zone_v2.zone_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_transfer_accept_v2.accept_1", "zone_transfer_request_id",
+						"openstack_dns_transfer_request_v2.request_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_transfer_accept_v2.accept_1", "status", "COMPLETE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSV2TransferAccept_basic(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "openstack_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email1@example.com"
+			ttl = 3000
+		}
+
+		resource "openstack_dns_transfer_request_v2" "request_1" {
+			zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+		}
+
+		resource "openstack_dns_transfer_accept_v2" "accept_1" {
+			zone_transfer_request_id = "${openstack_dns_transfer_request_v2.request_1.id}"
+			key = "${openstack_dns_transfer_request_v2.request_1.key}"
+		}
+	`, zoneName)
+}
@@ -0,0 +1,162 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDNSTransferRequestV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSTransferRequestV2Create,
+		Read:   resourceDNSTransferRequestV2Read,
+		Update: resourceDNSTransferRequestV2Update,
+		Delete: resourceDNSTransferRequestV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"target_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"zone_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSTransferRequestV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	createOpts := dnsTransferRequestV2CreateOpts{
+		TargetProjectID: d.Get("target_project_id").(string),
+		Description:     d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_dns_transfer_request_v2 create options for zone %s: %#v", zoneID, createOpts)
+	n, err := dnsTransferRequestV2Create(dnsClient, zoneID, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_dns_transfer_request_v2: %s", err)
+	}
+
+	d.SetId(n.ID)
+
+	log.Printf("[DEBUG] Created openstack_dns_transfer_request_v2 %s for zone %s", n.ID, zoneID)
+	return resourceDNSTransferRequestV2Read(d, meta)
+}
+
+func resourceDNSTransferRequestV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	n, err := dnsTransferRequestV2Get(dnsClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_dns_transfer_request_v2")
+	}
+
+	// Don't log the key.
+	log.Printf("[DEBUG] Retrieved openstack_dns_transfer_request_v2 %s for zone %s with status %s", d.Id(), n.ZoneID, n.Status)
+
+	d.Set("zone_id", n.ZoneID)
+	d.Set("zone_name", n.ZoneName)
+	d.Set("target_project_id", n.TargetProjectID)
+	d.Set("description", n.Description)
+	d.Set("key", n.Key)
+	d.Set("project_id", n.ProjectID)
+	d.Set("status", n.Status)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceDNSTransferRequestV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	var updateOpts dnsTransferRequestV2UpdateOpts
+	if d.HasChange("target_project_id") {
+		targetProjectID := d.Get("target_project_id").(string)
+		updateOpts.TargetProjectID = &targetProjectID
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] Updating openstack_dns_transfer_request_v2 %s with options: %#v", d.Id(), updateOpts)
+	err = dnsTransferRequestV2Update(dnsClient, d.Id(), updateOpts)
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_dns_transfer_request_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceDNSTransferRequestV2Read(d, meta)
+}
+
+func resourceDNSTransferRequestV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	err = dnsTransferRequestV2Delete(dnsClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_dns_transfer_request_v2")
+	}
+
+	return nil
+}
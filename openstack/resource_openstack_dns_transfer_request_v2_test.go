@@ -0,0 +1,113 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDNSV2TransferRequest_basic(t *testing.T) {
+	var zoneName = fmt.Sprintf("ACPTTEST%s.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckDNS(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2TransferRequestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2TransferRequest_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_transfer_request_v2.request_1", "zone_id",
+						"openstack_dns_zone_v2.zone_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_transfer_request_v2.request_1", "target_project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_transfer_request_v2.request_1", "zone_name", zoneName),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_transfer_request_v2.request_1", "description", "a transfer request"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_transfer_request_v2.request_1", "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_dns_transfer_request_v2.request_1", "key"),
+				),
+			},
+			{
+				Config: testAccDNSV2TransferRequest_update(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_dns_transfer_request_v2.request_1", "target_project_id", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_transfer_request_v2.request_1", "description", "an updated transfer request"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2TransferRequestDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_dns_transfer_request_v2" {
+			continue
+		}
+
+		_, err := dnsTransferRequestV2Get(dnsClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Transfer request still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccDNSV2TransferRequest_basic(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "openstack_identity_project_v3" "project_1" {
+			name = "project_1"
+		}
+
+		resource "openstack_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email1@example.com"
+			ttl = 3000
+		}
+
+		resource "openstack_dns_transfer_request_v2" "request_1" {
+			zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+			target_project_id = "${openstack_identity_project_v3.project_1.id}"
+			description = "a transfer request"
+		}
+	`, zoneName)
+}
+
+func testAccDNSV2TransferRequest_update(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "openstack_identity_project_v3" "project_1" {
+			name = "project_1"
+		}
+
+		resource "openstack_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email1@example.com"
+			ttl = 3000
+		}
+
+		resource "openstack_dns_transfer_request_v2" "request_1" {
+			zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+			description = "an updated transfer request"
+		}
+	`, zoneName)
+}
@@ -0,0 +1,186 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceImagesImageAccessAcceptV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImagesImageAccessAcceptV2Create,
+		Read:   resourceImagesImageAccessAcceptV2Read,
+		Update: resourceImagesImageAccessAcceptV2Update,
+		Delete: resourceImagesImageAccessAcceptV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"accepted", "rejected", "pending",
+				}, false),
+			},
+
+			// Computed-only
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImagesImageAccessAcceptV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	memberID, err := imagesImageAccessV2DetectMemberID(d, config)
+	if err != nil {
+		return err
+	}
+
+	// The image must have been shared with the member by its owner first.
+	if _, err := members.Get(imageClient, imageID, memberID).Extract(); err != nil {
+		return fmt.Errorf("Error retrieving member %s of image %s: %s", memberID, imageID, err)
+	}
+
+	updateOpts := members.UpdateOpts{
+		Status: d.Get("status").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_images_image_access_accept_v2 update options for member %s of image %s: %#v", memberID, imageID, updateOpts)
+	member, err := members.Update(imageClient, imageID, memberID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_images_image_access_accept_v2: %s", err)
+	}
+
+	d.SetId(imagesImageAccessV2ID(member.ImageID, member.MemberID))
+
+	return resourceImagesImageAccessAcceptV2Read(d, meta)
+}
+
+func resourceImagesImageAccessAcceptV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID, memberID, err := imagesImageAccessV2ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	member, err := members.Get(imageClient, imageID, memberID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_images_image_access_accept_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_images_image_access_accept_v2 %s: %#v", d.Id(), member)
+
+	d.Set("image_id", member.ImageID)
+	d.Set("member_id", member.MemberID)
+	d.Set("status", member.Status)
+	d.Set("schema", member.Schema)
+	d.Set("created_at", member.CreatedAt.Format(time.RFC3339))
+	d.Set("updated_at", member.UpdatedAt.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceImagesImageAccessAcceptV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID, memberID, err := imagesImageAccessV2ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("status") {
+		updateOpts := members.UpdateOpts{
+			Status: d.Get("status").(string),
+		}
+
+		log.Printf("[DEBUG] openstack_images_image_access_accept_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err = members.Update(imageClient, imageID, memberID, updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_images_image_access_accept_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceImagesImageAccessAcceptV2Read(d, meta)
+}
+
+func resourceImagesImageAccessAcceptV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID, memberID, err := imagesImageAccessV2ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// A member cannot remove itself from an image, so reset its status to
+	// pending.
+	updateOpts := members.UpdateOpts{
+		Status: "pending",
+	}
+
+	log.Printf("[DEBUG] openstack_images_image_access_accept_v2 %s update options: %#v", d.Id(), updateOpts)
+	_, err = members.Update(imageClient, imageID, memberID, updateOpts).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_images_image_access_accept_v2")
+	}
+
+	return nil
+}
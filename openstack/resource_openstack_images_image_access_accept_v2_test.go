@@ -0,0 +1,111 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccImagesImageAccessAcceptV2_basic(t *testing.T) {
+	var member members.Member
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageAccessAcceptV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImageAccessAcceptV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageAccessV2Exists("openstack_images_image_access_accept_v2.image_access_accept_1", &member),
+					resource.TestCheckResourceAttrPair(
+						"openstack_images_image_access_accept_v2.image_access_accept_1", "member_id",
+						"data.openstack_identity_auth_scope_v3.scope", "project_id"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_access_accept_v2.image_access_accept_1", "status", "accepted"),
+				),
+			},
+			{
+				Config: testAccImagesImageAccessAcceptV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageAccessV2Exists("openstack_images_image_access_accept_v2.image_access_accept_1", &member),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_access_accept_v2.image_access_accept_1", "status", "rejected"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesImageAccessAcceptV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_images_image_access_accept_v2" {
+			continue
+		}
+
+		imageID, memberID, err := imagesImageAccessV2ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		member, err := members.Get(imageClient, imageID, memberID).Extract()
+		if err == nil && member.Status != "pending" {
+			return fmt.Errorf("Image member is still %s", member.Status)
+		}
+	}
+
+	return nil
+}
+
+const testAccImagesImageAccessAcceptV2_share = `
+data "openstack_identity_auth_scope_v3" "scope" {
+  name = "scope"
+}
+
+resource "openstack_images_image_v2" "image_1" {
+  name   = "CirrOS-tf_1"
+  image_source_url = "http://download.cirros-cloud.net/0.3.5/cirros-0.3.5-x86_64-disk.img"
+  container_format = "bare"
+  disk_format = "qcow2"
+  visibility = "shared"
+
+  timeouts {
+    create = "10m"
+  }
+}
+
+resource "openstack_images_image_access_v2" "image_access_1" {
+  image_id  = "${openstack_images_image_v2.image_1.id}"
+  member_id = "${data.openstack_identity_auth_scope_v3.scope.project_id}"
+}
+`
+
+var testAccImagesImageAccessAcceptV2_basic = fmt.Sprintf(`
+%s
+
+resource "openstack_images_image_access_accept_v2" "image_access_accept_1" {
+  image_id = "${openstack_images_image_access_v2.image_access_1.image_id}"
+  status   = "accepted"
+}
+`, testAccImagesImageAccessAcceptV2_share)
+
+var testAccImagesImageAccessAcceptV2_update = fmt.Sprintf(`
+%s
+
+resource "openstack_images_image_access_accept_v2" "image_access_accept_1" {
+  image_id = "${openstack_images_image_access_v2.image_access_1.image_id}"
+  status   = "rejected"
+}
+`, testAccImagesImageAccessAcceptV2_share)
@@ -0,0 +1,180 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceImagesImageAccessV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImagesImageAccessV2Create,
+		Read:   resourceImagesImageAccessV2Read,
+		Update: resourceImagesImageAccessV2Update,
+		Delete: resourceImagesImageAccessV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"accepted", "rejected", "pending",
+				}, false),
+			},
+
+			// Computed-only
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImagesImageAccessV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	memberID := d.Get("member_id").(string)
+
+	log.Printf("[DEBUG] openstack_images_image_access_v2 adding member %s to image %s", memberID, imageID)
+	member, err := members.Create(imageClient, imageID, memberID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_images_image_access_v2: %s", err)
+	}
+
+	d.SetId(imagesImageAccessV2ID(member.ImageID, member.MemberID))
+
+	// Only admins are able to change the status of a member on the owner side.
+	if v, ok := d.GetOk("status"); ok && v.(string) != member.Status {
+		updateOpts := members.UpdateOpts{
+			Status: v.(string),
+		}
+
+		log.Printf("[DEBUG] openstack_images_image_access_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err = members.Update(imageClient, imageID, memberID, updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_images_image_access_v2 %s status: %s", d.Id(), err)
+		}
+	}
+
+	return resourceImagesImageAccessV2Read(d, meta)
+}
+
+func resourceImagesImageAccessV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID, memberID, err := imagesImageAccessV2ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	member, err := members.Get(imageClient, imageID, memberID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_images_image_access_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_images_image_access_v2 %s: %#v", d.Id(), member)
+
+	d.Set("image_id", member.ImageID)
+	d.Set("member_id", member.MemberID)
+	d.Set("status", member.Status)
+	d.Set("schema", member.Schema)
+	d.Set("created_at", member.CreatedAt.Format(time.RFC3339))
+	d.Set("updated_at", member.UpdatedAt.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceImagesImageAccessV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID, memberID, err := imagesImageAccessV2ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("status") {
+		updateOpts := members.UpdateOpts{
+			Status: d.Get("status").(string),
+		}
+
+		log.Printf("[DEBUG] openstack_images_image_access_v2 %s update options: %#v", d.Id(), updateOpts)
+		_, err = members.Update(imageClient, imageID, memberID, updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_images_image_access_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceImagesImageAccessV2Read(d, meta)
+}
+
+func resourceImagesImageAccessV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID, memberID, err := imagesImageAccessV2ParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = members.Delete(imageClient, imageID, memberID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_images_image_access_v2")
+	}
+
+	return nil
+}
@@ -1,23 +1,20 @@
 package openstack
 
 import (
-	"crypto/md5"
-	"encoding/hex"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
 	"os"
-	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imagedata"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceImagesImageV2() *schema.Resource {
@@ -64,9 +61,10 @@ func resourceImagesImageV2() *schema.Resource {
 			},
 
 			"image_cache_path": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  fmt.Sprintf("%s/.terraform/image_cache", os.Getenv("HOME")),
+				Type:       schema.TypeString,
+				Optional:   true,
+				Default:    fmt.Sprintf("%s/.terraform/image_cache", os.Getenv("HOME")),
+				Deprecated: "Images are streamed to the Image service and no longer cached",
 			},
 
 			"image_source_url": {
@@ -76,6 +74,15 @@ func resourceImagesImageV2() *schema.Resource {
 				ConflictsWith: []string{"local_file_path"},
 			},
 
+			"import_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(imageimport.WebDownloadMethod), string(imageimport.GlanceDirectMethod),
+				}, false),
+			},
+
 			"local_file_path": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -151,6 +158,16 @@ func resourceImagesImageV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"os_hash_algo": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"os_hash_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"metadata": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -219,6 +236,11 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 		createOpts.Tags = resourceImagesImageV2BuildTags(tags)
 	}
 
+	importMethod := d.Get("import_method").(string)
+	if importMethod == string(imageimport.WebDownloadMethod) && d.Get("image_source_url").(string) == "" {
+		return fmt.Errorf("image_source_url is required when import_method is %q", importMethod)
+	}
+
 	d.Partial(true)
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -229,28 +251,47 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(newImg.ID)
 
-	// downloading/getting image file props
-	imgFilePath, err := resourceImagesImageV2File(d)
-	if err != nil {
-		return fmt.Errorf("Error opening file for Image: %s", err)
+	var hashes *imagesImageV2Hashes
+	if importMethod == string(imageimport.WebDownloadMethod) {
+		// The image data is downloaded by the Image service itself.
+		furl := d.Get("image_source_url").(string)
+		log.Printf("[WARN] Importing image %s from %s. This can be pretty long.", d.Id(), furl)
 
-	}
-	fileSize, fileChecksum, err := resourceImagesImageV2FileProps(imgFilePath)
-	if err != nil {
-		return fmt.Errorf("Error getting file props: %s", err)
-	}
+		if err := imagesImageV2Import(imageClient, d.Id(), importMethod, furl); err != nil {
+			return fmt.Errorf("Error importing image %s from %q: %s", d.Id(), furl, err)
+		}
+	} else {
+		// The image data is streamed to the Image service and hashed on the fly.
+		imgSource, err := imagesImageV2Source(d)
+		if err != nil {
+			return err
+		}
+		defer imgSource.Close()
 
-	// upload
-	imgFile, err := os.Open(imgFilePath)
-	if err != nil {
-		return fmt.Errorf("Error opening file %q: %s", imgFilePath, err)
-	}
-	defer imgFile.Close()
-	log.Printf("[WARN] Uploading image %s (%d bytes). This can be pretty long.", d.Id(), fileSize)
+		imgData := newImagesImageV2Reader(imgSource)
+		hashes = imgData.hashes
+
+		if importMethod == string(imageimport.GlanceDirectMethod) {
+			log.Printf("[WARN] Staging image %s. This can be pretty long.", d.Id())
+
+			res := imagedata.Stage(imageClient, d.Id(), imgData)
+			if res.Err != nil {
+				return fmt.Errorf("Error while staging image %s: %s", d.Id(), res.Err)
+			}
+
+			if err := imagesImageV2Import(imageClient, d.Id(), importMethod, ""); err != nil {
+				return fmt.Errorf("Error importing image %s: %s", d.Id(), err)
+			}
+		} else {
+			log.Printf("[WARN] Uploading image %s. This can be pretty long.", d.Id())
 
-	res := imagedata.Upload(imageClient, d.Id(), imgFile)
-	if res.Err != nil {
-		return fmt.Errorf("Error while uploading file %q: %s", imgFilePath, res.Err)
+			res := imagedata.Upload(imageClient, d.Id(), imgData)
+			if res.Err != nil {
+				return fmt.Errorf("Error while uploading image %s: %s", d.Id(), res.Err)
+			}
+		}
+
+		log.Printf("[DEBUG] Sent %d bytes of image %s", hashes.size, d.Id())
 	}
 
 	//wait for active
@@ -263,6 +304,11 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 		MinTimeout: 3 * time.Second,
 	}
 
+	if importMethod != "" {
+		stateConf.Pending = append(stateConf.Pending, imagesImageV2StatusUploading, string(images.ImageStatusImporting))
+		stateConf.Refresh = imagesImageV2ImportRefreshFunc(imageClient, d.Id())
+	}
+
 	if _, err = stateConf.WaitForState(); err != nil {
 		return fmt.Errorf("Error waiting for Image: %s", err)
 	}
@@ -272,9 +318,12 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 		return CheckDeleted(d, err, "image")
 	}
 
-	verifyChecksum := d.Get("verify_checksum").(bool)
-	if img.Checksum != fileChecksum && verifyChecksum {
-		return fmt.Errorf("Error wrong checksum: got %q, expected %q", img.Checksum, fileChecksum)
+	// The image data is not sent by Terraform when the image is imported
+	// with the web-download method, so there is nothing to compare with.
+	if d.Get("verify_checksum").(bool) && hashes != nil {
+		if err := imagesImageV2Verify(img, hashes); err != nil {
+			return err
+		}
 	}
 
 	d.Partial(false)
@@ -301,6 +350,8 @@ func resourceImagesImageV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("file", img.File)
 	d.Set("schema", img.Schema)
 	d.Set("checksum", img.Checksum)
+	d.Set("os_hash_algo", img.Properties[imagesImageV2HashAlgoProperty])
+	d.Set("os_hash_value", img.Properties[imagesImageV2HashValueProperty])
 	d.Set("size_bytes", img.SizeBytes)
 	d.Set("metadata", img.Metadata)
 	d.Set("created_at", img.CreatedAt.Format(time.RFC3339))
@@ -532,77 +583,6 @@ func resourceImagesImageV2VisibilityFromString(v string) images.ImageVisibility
 	return ""
 }
 
-func fileMD5Checksum(f *os.File) (string, error) {
-	hash := md5.New()
-	if _, err := io.Copy(hash, f); err != nil {
-		return "", err
-	}
-	return hex.EncodeToString(hash.Sum(nil)), nil
-}
-
-func resourceImagesImageV2FileProps(filename string) (int64, string, error) {
-	var filesize int64
-	var filechecksum string
-
-	file, err := os.Open(filename)
-	if err != nil {
-		return -1, "", fmt.Errorf("Error opening file for Image: %s", err)
-
-	}
-	defer file.Close()
-
-	fstat, err := file.Stat()
-	if err != nil {
-		return -1, "", fmt.Errorf("Error reading image file %q: %s", file.Name(), err)
-	}
-
-	filesize = fstat.Size()
-	filechecksum, err = fileMD5Checksum(file)
-
-	if err != nil {
-		return -1, "", fmt.Errorf("Error computing image file %q checksum: %s", file.Name(), err)
-	}
-
-	return filesize, filechecksum, nil
-}
-
-func resourceImagesImageV2File(d *schema.ResourceData) (string, error) {
-	if filename := d.Get("local_file_path").(string); filename != "" {
-		return filename, nil
-	} else if furl := d.Get("image_source_url").(string); furl != "" {
-		dir := d.Get("image_cache_path").(string)
-		os.MkdirAll(dir, 0700)
-		filename := filepath.Join(dir, fmt.Sprintf("%x.img", md5.Sum([]byte(furl))))
-
-		if _, err := os.Stat(filename); err != nil {
-			if !os.IsNotExist(err) {
-				return "", fmt.Errorf("Error while trying to access file %q: %s", filename, err)
-			}
-			log.Printf("[DEBUG] File doens't exists %s. will download from %s", filename, furl)
-			file, err := os.Create(filename)
-			if err != nil {
-				return "", fmt.Errorf("Error creating file %q: %s", filename, err)
-			}
-			defer file.Close()
-			resp, err := http.Get(furl)
-			if err != nil {
-				return "", fmt.Errorf("Error downloading image from %q", furl)
-			}
-			defer resp.Body.Close()
-
-			if _, err = io.Copy(file, resp.Body); err != nil {
-				return "", fmt.Errorf("Error downloading image %q to file %q: %s", furl, filename, err)
-			}
-			return filename, nil
-		} else {
-			log.Printf("[DEBUG] File exists %s", filename)
-			return filename, nil
-		}
-	} else {
-		return "", fmt.Errorf("Error in config. no file specified")
-	}
-}
-
 func resourceImagesImageV2RefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		img, err := images.Get(client, id).Extract()
@@ -30,6 +30,10 @@ func TestAccImagesImageV2_basic(t *testing.T) {
 						"openstack_images_image_v2.image_1", "disk_format", "qcow2"),
 					resource.TestCheckResourceAttr(
 						"openstack_images_image_v2.image_1", "schema", "/v2/schemas/image"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "os_hash_algo", "sha512"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_images_image_v2.image_1", "os_hash_value"),
 				),
 			},
 		},
@@ -203,6 +207,50 @@ func TestAccImagesImageV2_properties(t *testing.T) {
 	})
 }
 
+func TestAccImagesImageV2_webDownload(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImageV2_webDownload,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "import_method", "web-download"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImagesImageV2_glanceDirect(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImageV2_glanceDirect,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "import_method", "glance-direct"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckImagesImageV2Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	imageClient, err := config.imageV2Client(OS_REGION_NAME)
@@ -448,3 +496,29 @@ const testAccImagesImageV2_properties_4 = `
         bar = "foo"
       }
   }`
+
+const testAccImagesImageV2_webDownload = `
+  resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      import_method = "web-download"
+      container_format = "bare"
+      disk_format = "qcow2"
+
+      timeouts {
+        create = "10m"
+      }
+  }`
+
+const testAccImagesImageV2_glanceDirect = `
+  resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      import_method = "glance-direct"
+      container_format = "bare"
+      disk_format = "qcow2"
+
+      timeouts {
+        create = "10m"
+      }
+  }`
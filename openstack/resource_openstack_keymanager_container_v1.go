@@ -0,0 +1,270 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/acls"
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/containers"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceKeyManagerContainerV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyManagerContainerV1Create,
+		Read:   resourceKeyManagerContainerV1Read,
+		Update: resourceKeyManagerContainerV1Update,
+		Delete: resourceKeyManagerContainerV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"generic", "rsa", "certificate",
+				}, false),
+			},
+
+			"secret_refs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"secret_ref": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"acl": keyManagerV1ACLSchema(),
+
+			"container_ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creator_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"consumers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyManagerContainerV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	createOpts := containers.CreateOpts{
+		Name:       d.Get("name").(string),
+		Type:       containers.ContainerType(d.Get("type").(string)),
+		SecretRefs: expandKeyManagerContainerV1SecretRefs(d.Get("secret_refs").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] openstack_keymanager_container_v1 create options: %#v", createOpts)
+
+	container, err := containers.Create(kmClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_keymanager_container_v1: %s", err)
+	}
+
+	id, err := keyManagerV1ParseRef(container.ContainerRef)
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    keyManagerContainerV1StateRefreshFunc(kmClient, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_keymanager_container_v1 %s to become active: %s", id, err)
+	}
+
+	for _, setOpts := range expandKeyManagerV1ACLs(d.Get("acl")) {
+		_, err := acls.SetContainerACL(kmClient, id, setOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error setting ACL on openstack_keymanager_container_v1 %s: %s", id, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Created openstack_keymanager_container_v1 %s: %#v", id, container)
+
+	return resourceKeyManagerContainerV1Read(d, meta)
+}
+
+func resourceKeyManagerContainerV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	container, err := containers.Get(kmClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_keymanager_container_v1")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_keymanager_container_v1 %s: %#v", d.Id(), container)
+
+	d.Set("name", container.Name)
+	d.Set("type", container.Type)
+	d.Set("container_ref", container.ContainerRef)
+	d.Set("creator_id", container.CreatorID)
+	d.Set("status", container.Status)
+	d.Set("created_at", container.Created.Format(time.RFC3339))
+	d.Set("updated_at", container.Updated.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("secret_refs", flattenKeyManagerContainerV1SecretRefs(container.SecretRefs)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_keymanager_container_v1 %s secret_refs: %s", d.Id(), err)
+	}
+
+	if err := d.Set("consumers", flattenKeyManagerContainerV1Consumers(container.Consumers)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_keymanager_container_v1 %s consumers: %s", d.Id(), err)
+	}
+
+	acl, err := acls.GetContainerACL(kmClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving ACL of openstack_keymanager_container_v1 %s: %s", d.Id(), err)
+	}
+	d.Set("acl", flattenKeyManagerV1ACLs(keyManagerV1StoredACLs(acl)))
+
+	return nil
+}
+
+func resourceKeyManagerContainerV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	if d.HasChange("acl") {
+		allSetOpts := expandKeyManagerV1ACLs(d.Get("acl"))
+
+		// Removing the acl restores the default ACL.
+		if len(allSetOpts) == 0 {
+			err := acls.DeleteContainerACL(kmClient, d.Id()).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error deleting ACL of openstack_keymanager_container_v1 %s: %s", d.Id(), err)
+			}
+		}
+
+		for _, setOpts := range allSetOpts {
+			_, err := acls.SetContainerACL(kmClient, d.Id(), setOpts).Extract()
+			if err != nil {
+				return fmt.Errorf("Error updating ACL of openstack_keymanager_container_v1 %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceKeyManagerContainerV1Read(d, meta)
+}
+
+func resourceKeyManagerContainerV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	err = containers.Delete(kmClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_keymanager_container_v1")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE", "PENDING"},
+		Target:     []string{"DELETED"},
+		Refresh:    keyManagerContainerV1StateRefreshFunc(kmClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_keymanager_container_v1 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
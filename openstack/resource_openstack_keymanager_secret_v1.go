@@ -0,0 +1,356 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/acls"
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/secrets"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceKeyManagerSecretV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyManagerSecretV1Create,
+		Read:   resourceKeyManagerSecretV1Read,
+		Update: resourceKeyManagerSecretV1Update,
+		Delete: resourceKeyManagerSecretV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"bit_length": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"secret_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(keyManagerSecretV1SecretTypes(), false),
+			},
+
+			"payload": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Sensitive:        true,
+				ForceNew:         true,
+				DiffSuppressFunc: keyManagerSecretV1PayloadDiffSuppressFunc,
+			},
+
+			"payload_content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"text/plain",
+					"text/plain;charset=utf-8",
+					"text/plain; charset=utf-8",
+					"application/octet-stream",
+					"application/pkcs8",
+					"application/pkix-cert",
+				}, true),
+			},
+
+			"payload_content_encoding": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"base64", "binary",
+				}, false),
+			},
+
+			"expiration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivilentTimeDiffs,
+			},
+
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"acl": keyManagerV1ACLSchema(),
+
+			"secret_ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creator_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_types": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyManagerSecretV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	createOpts := secrets.CreateOpts{
+		Name:                   d.Get("name").(string),
+		Algorithm:              d.Get("algorithm").(string),
+		BitLength:              d.Get("bit_length").(int),
+		Mode:                   d.Get("mode").(string),
+		PayloadContentType:     d.Get("payload_content_type").(string),
+		PayloadContentEncoding: d.Get("payload_content_encoding").(string),
+		SecretType:             secrets.SecretType(d.Get("secret_type").(string)),
+	}
+
+	if v, ok := d.GetOk("expiration"); ok {
+		expiration, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing expiration for openstack_keymanager_secret_v1: %s", err)
+		}
+		createOpts.Expiration = &expiration
+	}
+
+	log.Printf("[DEBUG] openstack_keymanager_secret_v1 create options: %#v", createOpts)
+
+	createOpts.Payload = d.Get("payload").(string)
+
+	secret, err := secrets.Create(kmClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_keymanager_secret_v1: %s", err)
+	}
+
+	id, err := keyManagerV1ParseRef(secret.SecretRef)
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    keyManagerSecretV1StateRefreshFunc(kmClient, id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_keymanager_secret_v1 %s to become active: %s", id, err)
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		metadataOpts := secrets.MetadataOpts(expandToMapStringString(v.(map[string]interface{})))
+		_, err := secrets.CreateMetadata(kmClient, id, metadataOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error setting metadata on openstack_keymanager_secret_v1 %s: %s", id, err)
+		}
+	}
+
+	for _, setOpts := range expandKeyManagerV1ACLs(d.Get("acl")) {
+		_, err := acls.SetSecretACL(kmClient, id, setOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error setting ACL on openstack_keymanager_secret_v1 %s: %s", id, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Created openstack_keymanager_secret_v1 %s: %#v", id, secret)
+
+	return resourceKeyManagerSecretV1Read(d, meta)
+}
+
+func resourceKeyManagerSecretV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	secret, err := secrets.Get(kmClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_keymanager_secret_v1")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_keymanager_secret_v1 %s: %#v", d.Id(), secret)
+
+	d.Set("name", secret.Name)
+	d.Set("bit_length", secret.BitLength)
+	d.Set("algorithm", secret.Algorithm)
+	d.Set("mode", secret.Mode)
+	d.Set("secret_type", secret.SecretType)
+	d.Set("secret_ref", secret.SecretRef)
+	d.Set("creator_id", secret.CreatorID)
+	d.Set("status", secret.Status)
+	d.Set("content_types", secret.ContentTypes)
+	d.Set("created_at", secret.Created.Format(time.RFC3339))
+	d.Set("updated_at", secret.Updated.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	if secret.Expiration.IsZero() {
+		d.Set("expiration", "")
+	} else {
+		d.Set("expiration", secret.Expiration.Format(time.RFC3339))
+	}
+
+	// A secret only has content types once a payload was stored.
+	if defaultContentType, ok := secret.ContentTypes["default"]; ok {
+		contentType := d.Get("payload_content_type").(string)
+		if contentType == "" {
+			contentType = defaultContentType
+			d.Set("payload_content_type", contentType)
+		}
+
+		payload, err := keyManagerSecretV1GetPayload(kmClient, d.Id(), contentType, d.Get("payload_content_encoding").(string))
+		if err != nil {
+			return fmt.Errorf("Error retrieving payload of openstack_keymanager_secret_v1 %s: %s", d.Id(), err)
+		}
+		d.Set("payload", payload)
+	}
+
+	metadata, err := secrets.GetMetadata(kmClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving metadata of openstack_keymanager_secret_v1 %s: %s", d.Id(), err)
+	}
+	d.Set("metadata", metadata)
+
+	acl, err := acls.GetSecretACL(kmClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving ACL of openstack_keymanager_secret_v1 %s: %s", d.Id(), err)
+	}
+	d.Set("acl", flattenKeyManagerV1ACLs(keyManagerV1StoredACLs(acl)))
+
+	return nil
+}
+
+func resourceKeyManagerSecretV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	if d.HasChange("metadata") {
+		// Barbican replaces all of the metadata of a secret at once.
+		metadataOpts := secrets.MetadataOpts(expandToMapStringString(d.Get("metadata").(map[string]interface{})))
+		_, err := secrets.CreateMetadata(kmClient, d.Id(), metadataOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating metadata of openstack_keymanager_secret_v1 %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("acl") {
+		allSetOpts := expandKeyManagerV1ACLs(d.Get("acl"))
+
+		// Removing the acl restores the default ACL.
+		if len(allSetOpts) == 0 {
+			err := acls.DeleteSecretACL(kmClient, d.Id()).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error deleting ACL of openstack_keymanager_secret_v1 %s: %s", d.Id(), err)
+			}
+		}
+
+		for _, setOpts := range allSetOpts {
+			_, err := acls.SetSecretACL(kmClient, d.Id(), setOpts).Extract()
+			if err != nil {
+				return fmt.Errorf("Error updating ACL of openstack_keymanager_secret_v1 %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceKeyManagerSecretV1Read(d, meta)
+}
+
+func resourceKeyManagerSecretV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	err = secrets.Delete(kmClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_keymanager_secret_v1")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ACTIVE", "PENDING"},
+		Target:     []string{"DELETED"},
+		Refresh:    keyManagerSecretV1StateRefreshFunc(kmClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_keymanager_secret_v1 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
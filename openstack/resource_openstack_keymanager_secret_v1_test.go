@@ -0,0 +1,193 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/keymanager/v1/secrets"
+)
+
+func TestAccKeyManagerSecretV1_basic(t *testing.T) {
+	var secret secrets.Secret
+	secretName := fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerSecretV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyManagerSecretV1_basic(secretName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerSecretV1Exists("openstack_keymanager_secret_v1.secret_1", &secret),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "name", secretName),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "secret_type", "passphrase"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "payload", "foobar"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "metadata.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccKeyManagerSecretV1_update(secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "metadata.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "metadata.foo", "baz"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "acl.0.read.0.project_access", "false"),
+				),
+			},
+			{
+				Config: testAccKeyManagerSecretV1_basic(secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "metadata.foo", "bar"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "acl.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyManagerSecretV1_base64(t *testing.T) {
+	var secret secrets.Secret
+	secretName := fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerSecretV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyManagerSecretV1_base64(secretName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerSecretV1Exists("openstack_keymanager_secret_v1.secret_1", &secret),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "payload", "Zm9vYmFy"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "payload_content_type", "application/octet-stream"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyManagerSecretV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_keymanager_secret_v1" {
+			continue
+		}
+
+		_, err := secrets.Get(kmClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Secret still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKeyManagerSecretV1Exists(n string, secret *secrets.Secret) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+		}
+
+		found, err := secrets.Get(kmClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		id, err := keyManagerV1ParseRef(found.SecretRef)
+		if err != nil {
+			return err
+		}
+
+		if id != rs.Primary.ID {
+			return fmt.Errorf("Secret not found")
+		}
+
+		*secret = *found
+
+		return nil
+	}
+}
+
+func testAccKeyManagerSecretV1_basic(secretName string) string {
+	return fmt.Sprintf(`
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "%s"
+  secret_type = "passphrase"
+  payload = "foobar"
+  payload_content_type = "text/plain"
+
+  metadata = {
+    foo = "bar"
+  }
+}
+`, secretName)
+}
+
+func testAccKeyManagerSecretV1_update(secretName string) string {
+	return fmt.Sprintf(`
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "%s"
+  secret_type = "passphrase"
+  payload = "foobar"
+  payload_content_type = "text/plain"
+
+  metadata = {
+    foo = "baz"
+  }
+
+  acl {
+    read {
+      project_access = false
+    }
+  }
+}
+`, secretName)
+}
+
+func testAccKeyManagerSecretV1_base64(secretName string) string {
+	return fmt.Sprintf(`
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "%s"
+  algorithm = "aes"
+  bit_length = 256
+  mode = "cbc"
+  secret_type = "symmetric"
+  payload = "Zm9vYmFy"
+  payload_content_type = "application/octet-stream"
+  payload_content_encoding = "base64"
+}
+`, secretName)
+}
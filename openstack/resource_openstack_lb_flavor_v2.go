@@ -0,0 +1,152 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLoadBalancerFlavorV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoadBalancerFlavorV2Create,
+		Read:   resourceLoadBalancerFlavorV2Read,
+		Update: resourceLoadBalancerFlavorV2Update,
+		Delete: resourceLoadBalancerFlavorV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"flavor_profile_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerFlavorV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := lbFlavorV2CreateOpts{
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		FlavorProfileID: d.Get("flavor_profile_id").(string),
+		Enabled:         &enabled,
+	}
+
+	log.Printf("[DEBUG] openstack_lb_flavor_v2 create options: %#v", createOpts)
+
+	flavor, err := lbFlavorV2Create(lbClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_lb_flavor_v2: %s", err)
+	}
+
+	d.SetId(flavor.ID)
+
+	log.Printf("[DEBUG] Created openstack_lb_flavor_v2 %s: %#v", flavor.ID, flavor)
+
+	return resourceLoadBalancerFlavorV2Read(d, meta)
+}
+
+func resourceLoadBalancerFlavorV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	flavor, err := lbFlavorV2Get(lbClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_lb_flavor_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_flavor_v2 %s: %#v", d.Id(), flavor)
+
+	d.Set("name", flavor.Name)
+	d.Set("description", flavor.Description)
+	d.Set("flavor_profile_id", flavor.FlavorProfileID)
+	d.Set("enabled", flavor.Enabled)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceLoadBalancerFlavorV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	var updateOpts lbFlavorV2UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("enabled") {
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	log.Printf("[DEBUG] openstack_lb_flavor_v2 %s update options: %#v", d.Id(), updateOpts)
+
+	err = lbFlavorV2Update(lbClient, d.Id(), updateOpts)
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_lb_flavor_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceLoadBalancerFlavorV2Read(d, meta)
+}
+
+func resourceLoadBalancerFlavorV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	err = lbFlavorV2Delete(lbClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_lb_flavor_v2")
+	}
+
+	return nil
+}
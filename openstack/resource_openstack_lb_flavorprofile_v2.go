@@ -0,0 +1,155 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceLoadBalancerFlavorProfileV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoadBalancerFlavorProfileV2Create,
+		Read:   resourceLoadBalancerFlavorProfileV2Read,
+		Update: resourceLoadBalancerFlavorProfileV2Update,
+		Delete: resourceLoadBalancerFlavorProfileV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"provider_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"flavor_data": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.ValidateJsonString,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceLoadBalancerFlavorProfileV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	createOpts := lbFlavorProfileV2CreateOpts{
+		Name:         d.Get("name").(string),
+		ProviderName: d.Get("provider_name").(string),
+		FlavorData:   d.Get("flavor_data").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_lb_flavorprofile_v2 create options: %#v", createOpts)
+
+	flavorProfile, err := lbFlavorProfileV2Create(lbClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_lb_flavorprofile_v2: %s", err)
+	}
+
+	d.SetId(flavorProfile.ID)
+
+	log.Printf("[DEBUG] Created openstack_lb_flavorprofile_v2 %s: %#v", flavorProfile.ID, flavorProfile)
+
+	return resourceLoadBalancerFlavorProfileV2Read(d, meta)
+}
+
+func resourceLoadBalancerFlavorProfileV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	flavorProfile, err := lbFlavorProfileV2Get(lbClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_lb_flavorprofile_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_flavorprofile_v2 %s: %#v", d.Id(), flavorProfile)
+
+	d.Set("name", flavorProfile.Name)
+	d.Set("provider_name", flavorProfile.ProviderName)
+	d.Set("region", GetRegion(d, config))
+
+	flavorData, err := structure.NormalizeJsonString(flavorProfile.FlavorData)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to normalize openstack_lb_flavorprofile_v2 %s flavor_data: %s", d.Id(), err)
+		flavorData = flavorProfile.FlavorData
+	}
+	d.Set("flavor_data", flavorData)
+
+	return nil
+}
+
+func resourceLoadBalancerFlavorProfileV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	var updateOpts lbFlavorProfileV2UpdateOpts
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("provider_name") {
+		providerName := d.Get("provider_name").(string)
+		updateOpts.ProviderName = &providerName
+	}
+
+	if d.HasChange("flavor_data") {
+		flavorData := d.Get("flavor_data").(string)
+		updateOpts.FlavorData = &flavorData
+	}
+
+	log.Printf("[DEBUG] openstack_lb_flavorprofile_v2 %s update options: %#v", d.Id(), updateOpts)
+
+	err = lbFlavorProfileV2Update(lbClient, d.Id(), updateOpts)
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_lb_flavorprofile_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceLoadBalancerFlavorProfileV2Read(d, meta)
+}
+
+func resourceLoadBalancerFlavorProfileV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	err = lbFlavorProfileV2Delete(lbClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_lb_flavorprofile_v2")
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
 )
 
@@ -107,10 +108,69 @@ func resourceListenerV2() *schema.Resource {
 				Default:  true,
 				Optional: true,
 			},
+
+			"timeout_client_data": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"timeout_member_connect": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"timeout_member_data": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"timeout_tcp_inspect": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"insert_headers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"allowed_cidrs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
+// listenerV2OctaviaAttributes are the attributes of a listener, which are
+// only supported by Octavia.
+var listenerV2OctaviaAttributes = []string{
+	"timeout_client_data",
+	"timeout_member_connect",
+	"timeout_member_data",
+	"timeout_tcp_inspect",
+	"insert_headers",
+	"allowed_cidrs",
+}
+
+// listenerV2CheckOctaviaVersion returns an error if the Octavia API doesn't
+// support the version required by the attributes of a listener.
+func listenerV2CheckOctaviaVersion(d *schema.ResourceData, lbClient *gophercloud.ServiceClient) error {
+	err := lbV2CheckOctaviaVersion(d, lbClient, "openstack_lb_listener_v2", "2.1",
+		"timeout_client_data", "timeout_member_connect", "timeout_member_data", "timeout_tcp_inspect")
+	if err != nil {
+		return err
+	}
+
+	return lbV2CheckOctaviaVersion(d, lbClient, "openstack_lb_listener_v2", "2.12", "allowed_cidrs")
+}
+
 func resourceListenerV2Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	lbClient, err := chooseLBV2Client(d, config)
@@ -118,6 +178,16 @@ func resourceListenerV2Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
+	err = lbV2CheckOctaviaAttributes(d, config, "openstack_lb_listener_v2", listenerV2OctaviaAttributes...)
+	if err != nil {
+		return err
+	}
+
+	err = listenerV2CheckOctaviaVersion(d, lbClient)
+	if err != nil {
+		return err
+	}
+
 	adminStateUp := d.Get("admin_state_up").(bool)
 	var sniContainerRefs []string
 	if raw, ok := d.GetOk("sni_container_refs"); ok {
@@ -125,7 +195,7 @@ func resourceListenerV2Create(d *schema.ResourceData, meta interface{}) error {
 			sniContainerRefs = append(sniContainerRefs, v.(string))
 		}
 	}
-	createOpts := listeners.CreateOpts{
+	baseCreateOpts := listeners.CreateOpts{
 		Protocol:               listeners.Protocol(d.Get("protocol").(string)),
 		ProtocolPort:           d.Get("protocol_port").(int),
 		TenantID:               d.Get("tenant_id").(string),
@@ -140,12 +210,35 @@ func resourceListenerV2Create(d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("connection_limit"); ok {
 		connectionLimit := v.(int)
-		createOpts.ConnLimit = &connectionLimit
+		baseCreateOpts.ConnLimit = &connectionLimit
+	}
+
+	createOpts := ListenerV2OctaviaCreateOptsExt{
+		CreateOptsBuilder: baseCreateOpts,
+		InsertHeaders:     expandToMapStringString(d.Get("insert_headers").(map[string]interface{})),
+		AllowedCIDRs:      expandToStringSlice(d.Get("allowed_cidrs").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("timeout_client_data"); ok {
+		timeout := v.(int)
+		createOpts.TimeoutClientData = &timeout
+	}
+	if v, ok := d.GetOk("timeout_member_connect"); ok {
+		timeout := v.(int)
+		createOpts.TimeoutMemberConnect = &timeout
+	}
+	if v, ok := d.GetOk("timeout_member_data"); ok {
+		timeout := v.(int)
+		createOpts.TimeoutMemberData = &timeout
+	}
+	if v, ok := d.GetOk("timeout_tcp_inspect"); ok {
+		timeout := v.(int)
+		createOpts.TimeoutTCPInspect = &timeout
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
-	lbID := createOpts.LoadbalancerID
+	lbID := baseCreateOpts.LoadbalancerID
 	timeout := d.Timeout(schema.TimeoutCreate)
 
 	// Wait for LoadBalancer to become active before continuing
@@ -186,7 +279,8 @@ func resourceListenerV2Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	listener, err := listeners.Get(lbClient, d.Id()).Extract()
+	var listener listenerV2Extended
+	err = listeners.Get(lbClient, d.Id()).ExtractIntoStructPtr(&listener, "listener")
 	if err != nil {
 		return CheckDeleted(d, err, "listener")
 	}
@@ -208,6 +302,12 @@ func resourceListenerV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("connection_limit", listener.ConnLimit)
 	d.Set("sni_container_refs", listener.SniContainerRefs)
 	d.Set("default_tls_container_ref", listener.DefaultTlsContainerRef)
+	d.Set("timeout_client_data", listener.TimeoutClientData)
+	d.Set("timeout_member_connect", listener.TimeoutMemberConnect)
+	d.Set("timeout_member_data", listener.TimeoutMemberData)
+	d.Set("timeout_tcp_inspect", listener.TimeoutTCPInspect)
+	d.Set("insert_headers", listener.InsertHeaders)
+	d.Set("allowed_cidrs", listener.AllowedCIDRs)
 	d.Set("region", GetRegion(d, config))
 
 	return nil
@@ -226,6 +326,16 @@ func resourceListenerV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Unable to retrieve listener %s: %s", d.Id(), err)
 	}
 
+	err = lbV2CheckOctaviaAttributes(d, config, "openstack_lb_listener_v2", listenerV2OctaviaAttributes...)
+	if err != nil {
+		return err
+	}
+
+	err = listenerV2CheckOctaviaVersion(d, lbClient)
+	if err != nil {
+		return err
+	}
+
 	var updateOpts listeners.UpdateOpts
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
@@ -244,7 +354,8 @@ func resourceListenerV2Update(d *schema.ResourceData, meta interface{}) error {
 		updateOpts.DefaultPoolID = &defaultPoolID
 	}
 	if d.HasChange("default_tls_container_ref") {
-		updateOpts.DefaultTlsContainerRef = d.Get("default_tls_container_ref").(string)
+		defaultTLSContainerRef := d.Get("default_tls_container_ref").(string)
+		updateOpts.DefaultTlsContainerRef = &defaultTLSContainerRef
 	}
 	if d.HasChange("sni_container_refs") {
 		var sniContainerRefs []string
@@ -253,13 +364,41 @@ func resourceListenerV2Update(d *schema.ResourceData, meta interface{}) error {
 				sniContainerRefs = append(sniContainerRefs, v.(string))
 			}
 		}
-		updateOpts.SniContainerRefs = sniContainerRefs
+		updateOpts.SniContainerRefs = &sniContainerRefs
 	}
 	if d.HasChange("admin_state_up") {
 		asu := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &asu
 	}
 
+	octaviaUpdateOpts := ListenerV2OctaviaUpdateOptsExt{
+		UpdateOptsBuilder: updateOpts,
+	}
+	if d.HasChange("timeout_client_data") {
+		timeout := d.Get("timeout_client_data").(int)
+		octaviaUpdateOpts.TimeoutClientData = &timeout
+	}
+	if d.HasChange("timeout_member_connect") {
+		timeout := d.Get("timeout_member_connect").(int)
+		octaviaUpdateOpts.TimeoutMemberConnect = &timeout
+	}
+	if d.HasChange("timeout_member_data") {
+		timeout := d.Get("timeout_member_data").(int)
+		octaviaUpdateOpts.TimeoutMemberData = &timeout
+	}
+	if d.HasChange("timeout_tcp_inspect") {
+		timeout := d.Get("timeout_tcp_inspect").(int)
+		octaviaUpdateOpts.TimeoutTCPInspect = &timeout
+	}
+	if d.HasChange("insert_headers") {
+		insertHeaders := expandToMapStringString(d.Get("insert_headers").(map[string]interface{}))
+		octaviaUpdateOpts.InsertHeaders = &insertHeaders
+	}
+	if d.HasChange("allowed_cidrs") {
+		allowedCIDRs := expandToStringSlice(d.Get("allowed_cidrs").([]interface{}))
+		octaviaUpdateOpts.AllowedCIDRs = &allowedCIDRs
+	}
+
 	// Wait for the listener to become ACTIVE.
 	timeout := d.Timeout(schema.TimeoutUpdate)
 	err = waitForLBV2Listener(lbClient, listener, "ACTIVE", lbPendingStatuses, timeout)
@@ -267,9 +406,9 @@ func resourceListenerV2Update(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	log.Printf("[DEBUG] Updating listener %s with options: %#v", d.Id(), updateOpts)
+	log.Printf("[DEBUG] Updating listener %s with options: %#v", d.Id(), octaviaUpdateOpts)
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		_, err = listeners.Update(lbClient, d.Id(), updateOpts).Extract()
+		_, err = listeners.Update(lbClient, d.Id(), octaviaUpdateOpts).Extract()
 		if err != nil {
 			return checkForRetryableError(err)
 		}
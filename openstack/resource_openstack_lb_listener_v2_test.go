@@ -38,6 +38,44 @@ func TestAccLBV2Listener_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Listener_octavia(t *testing.T) {
+	var listener listeners.Listener
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOctavia(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2ListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccLBV2ListenerConfig_octavia,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2ListenerExists("openstack_lb_listener_v2.listener_1", &listener),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "timeout_client_data", "1000"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "timeout_member_connect", "2000"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "insert_headers.X-Forwarded-For", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "allowed_cidrs.#", "1"),
+				),
+			},
+			{
+				Config: TestAccLBV2ListenerConfig_octavia_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2ListenerExists("openstack_lb_listener_v2.listener_1", &listener),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "timeout_client_data", "3000"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "insert_headers.X-Forwarded-Port", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "allowed_cidrs.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2ListenerDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	lbClient, err := chooseLBV2AccTestClient(config, OS_REGION_NAME)
@@ -164,3 +202,93 @@ resource "openstack_lb_listener_v2" "listener_1" {
 	}
 }
 `
+
+const TestAccLBV2ListenerConfig_octavia = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+  timeout_client_data = 1000
+  timeout_member_connect = 2000
+  timeout_member_data = 3000
+  timeout_tcp_inspect = 4000
+
+  insert_headers = {
+    X-Forwarded-For = "true"
+  }
+
+  allowed_cidrs = [
+    "192.168.199.0/24",
+  ]
+
+	timeouts {
+		create = "5m"
+		update = "5m"
+		delete = "5m"
+	}
+}
+`
+
+const TestAccLBV2ListenerConfig_octavia_update = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+  timeout_client_data = 3000
+  timeout_member_connect = 2000
+  timeout_member_data = 3000
+  timeout_tcp_inspect = 4000
+
+  insert_headers = {
+    X-Forwarded-For = "true"
+    X-Forwarded-Port = "true"
+  }
+
+  allowed_cidrs = [
+    "192.168.199.0/24",
+    "10.0.0.0/8",
+  ]
+
+	timeouts {
+		create = "5m"
+		update = "5m"
+		delete = "5m"
+	}
+}
+`
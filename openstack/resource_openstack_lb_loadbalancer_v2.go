@@ -23,6 +23,8 @@ func resourceLoadBalancerV2() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceLoadBalancerV2CustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -49,8 +51,24 @@ func resourceLoadBalancerV2() *schema.Resource {
 
 			"vip_subnet_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
+				// Octavia chooses the subnet when only vip_network_id is set.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return new == "" && d.Get("vip_network_id").(string) != ""
+				},
+			},
+
+			"vip_network_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"vip_qos_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 
 			"tenant_id": {
@@ -98,6 +116,18 @@ func resourceLoadBalancerV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"failover": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -109,21 +139,30 @@ func resourceLoadBalancerV2Create(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
+	err = lbV2CheckOctaviaAttributes(d, config, "openstack_lb_loadbalancer_v2",
+		"vip_network_id", "vip_qos_policy_id", "availability_zone", "failover")
+	if err != nil {
+		return err
+	}
+
 	var lbProvider string
 	if v, ok := d.GetOk("loadbalancer_provider"); ok {
 		lbProvider = v.(string)
 	}
 
 	adminStateUp := d.Get("admin_state_up").(bool)
-	createOpts := loadbalancers.CreateOpts{
-		Name:         d.Get("name").(string),
-		Description:  d.Get("description").(string),
-		VipSubnetID:  d.Get("vip_subnet_id").(string),
-		TenantID:     d.Get("tenant_id").(string),
-		VipAddress:   d.Get("vip_address").(string),
-		AdminStateUp: &adminStateUp,
-		Flavor:       d.Get("flavor").(string),
-		Provider:     lbProvider,
+	createOpts := LoadBalancerV2CreateOpts{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		VipSubnetID:      d.Get("vip_subnet_id").(string),
+		VipNetworkID:     d.Get("vip_network_id").(string),
+		VipQosPolicyID:   d.Get("vip_qos_policy_id").(string),
+		TenantID:         d.Get("tenant_id").(string),
+		VipAddress:       d.Get("vip_address").(string),
+		AdminStateUp:     &adminStateUp,
+		FlavorID:         d.Get("flavor").(string),
+		Provider:         lbProvider,
+		AvailabilityZone: d.Get("availability_zone").(string),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -162,7 +201,8 @@ func resourceLoadBalancerV2Read(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	lb, err := loadbalancers.Get(lbClient, d.Id()).Extract()
+	var lb loadBalancerV2Extended
+	err = loadbalancers.Get(lbClient, d.Id()).ExtractIntoStructPtr(&lb, "loadbalancer")
 	if err != nil {
 		return CheckDeleted(d, err, "loadbalancer")
 	}
@@ -172,11 +212,14 @@ func resourceLoadBalancerV2Read(d *schema.ResourceData, meta interface{}) error
 	d.Set("name", lb.Name)
 	d.Set("description", lb.Description)
 	d.Set("vip_subnet_id", lb.VipSubnetID)
+	d.Set("vip_network_id", lb.VipNetworkID)
+	d.Set("vip_qos_policy_id", lb.VipQosPolicyID)
+	d.Set("availability_zone", lb.AvailabilityZone)
 	d.Set("tenant_id", lb.TenantID)
 	d.Set("vip_address", lb.VipAddress)
 	d.Set("vip_port_id", lb.VipPortID)
 	d.Set("admin_state_up", lb.AdminStateUp)
-	d.Set("flavor", lb.Flavor)
+	d.Set("flavor", lb.FlavorID)
 	d.Set("loadbalancer_provider", lb.Provider)
 	d.Set("region", GetRegion(d, config))
 
@@ -204,23 +247,48 @@ func resourceLoadBalancerV2Update(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	var updateOpts loadbalancers.UpdateOpts
+	err = lbV2CheckOctaviaAttributes(d, config, "openstack_lb_loadbalancer_v2",
+		"vip_qos_policy_id", "failover")
+	if err != nil {
+		return err
+	}
+
+	err = lbV2CheckOctaviaVersion(d, lbClient, "openstack_lb_loadbalancer_v2", "2.1", "failover")
+	if err != nil {
+		return err
+	}
+
+	var hasChange bool
+	var baseUpdateOpts loadbalancers.UpdateOpts
 	if d.HasChange("name") {
+		hasChange = true
 		name := d.Get("name").(string)
-		updateOpts.Name = &name
+		baseUpdateOpts.Name = &name
 	}
 	if d.HasChange("description") {
+		hasChange = true
 		description := d.Get("description").(string)
-		updateOpts.Description = &description
+		baseUpdateOpts.Description = &description
 	}
 	if d.HasChange("admin_state_up") {
+		hasChange = true
 		asu := d.Get("admin_state_up").(bool)
-		updateOpts.AdminStateUp = &asu
+		baseUpdateOpts.AdminStateUp = &asu
 	}
 
-	if updateOpts != (loadbalancers.UpdateOpts{}) {
+	updateOpts := LoadBalancerV2QoSUpdateOptsExt{
+		UpdateOptsBuilder: baseUpdateOpts,
+	}
+	if d.HasChange("vip_qos_policy_id") {
+		hasChange = true
+		vipQosPolicyID := d.Get("vip_qos_policy_id").(string)
+		updateOpts.VipQosPolicyID = &vipQosPolicyID
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if hasChange {
 		// Wait for LoadBalancer to become active before continuing
-		timeout := d.Timeout(schema.TimeoutUpdate)
 		err = waitForLBV2LoadBalancer(lbClient, d.Id(), "ACTIVE", lbPendingStatuses, timeout)
 		if err != nil {
 			return err
@@ -246,6 +314,33 @@ func resourceLoadBalancerV2Update(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	// A failover is triggered, whenever the value of failover changes. The
+	// value itself has no meaning.
+	if d.HasChange("failover") && d.Get("failover").(string) != "" {
+		err = waitForLBV2LoadBalancer(lbClient, d.Id(), "ACTIVE", lbPendingStatuses, timeout)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Triggering failover of loadbalancer %s", d.Id())
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			err = lbV2LoadBalancerFailover(lbClient, d.Id())
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("Error triggering failover of loadbalancer %s: %s", d.Id(), err)
+		}
+
+		err = waitForLBV2LoadBalancer(lbClient, d.Id(), "ACTIVE", lbPendingStatuses, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Security Groups get updated separately
 	if d.HasChange("security_group_ids") {
 		networkingClient, err := config.networkingV2Client(GetRegion(d, config))
@@ -311,3 +406,19 @@ func resourceLoadBalancerV2SecurityGroups(networkingClient *gophercloud.ServiceC
 
 	return nil
 }
+
+// resourceLoadBalancerV2CustomizeDiff ensures at plan time that vip_subnet_id
+// is set when Neutron-LBaaS is used, since only Octavia can allocate the VIP
+// on vip_network_id instead.
+func resourceLoadBalancerV2CustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	config := meta.(*Config)
+	if config.useOctavia {
+		return nil
+	}
+
+	if _, ok := diff.GetOk("vip_subnet_id"); ok || !diff.NewValueKnown("vip_subnet_id") {
+		return nil
+	}
+
+	return fmt.Errorf("vip_subnet_id of openstack_lb_loadbalancer_v2 is required, unless use_octavia is set to true in the provider configuration")
+}
@@ -102,6 +102,43 @@ func TestAccLBV2LoadBalancer_secGroup(t *testing.T) {
 	})
 }
 
+func TestAccLBV2LoadBalancer_vipNetworkQoS(t *testing.T) {
+	var lb loadbalancers.LoadBalancer
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckOctavia(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2LoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2LoadBalancer_vipNetworkQoS,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2LoadBalancerExists("openstack_lb_loadbalancer_v2.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "vip_network_id",
+						"openstack_networking_network_v2.network_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "vip_qos_policy_id",
+						"openstack_networking_qos_policy_v2.qos_policy_1", "id"),
+				),
+			},
+			{
+				Config: testAccLBV2LoadBalancer_vipNetworkQoS_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2LoadBalancerExists("openstack_lb_loadbalancer_v2.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "vip_qos_policy_id", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "failover", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2LoadBalancerDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	lbClient, err := chooseLBV2AccTestClient(config, OS_REGION_NAME)
@@ -329,3 +366,63 @@ resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
     depends_on = ["openstack_networking_secgroup_v2.secgroup_1"]
 }
 `
+
+const testAccLBV2LoadBalancer_vipNetworkQoS = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.199.0/24"
+}
+
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_network_id = "${openstack_networking_network_v2.network_1.id}"
+  vip_qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  depends_on = ["openstack_networking_subnet_v2.subnet_1"]
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`
+
+const testAccLBV2LoadBalancer_vipNetworkQoS_update = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.199.0/24"
+}
+
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_network_id = "${openstack_networking_network_v2.network_1.id}"
+  failover = "1"
+  depends_on = ["openstack_networking_subnet_v2.subnet_1"]
+
+  timeouts {
+    create = "5m"
+    update = "10m"
+    delete = "5m"
+  }
+}
+`
@@ -0,0 +1,238 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/gophercloud/gophercloud"
+	octaviapools "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+func resourceMembersV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMembersV2Create,
+		Read:   resourceMembersV2Read,
+		Update: resourceMembersV2Update,
+		Delete: resourceMembersV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      lbMembersV2Hash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"protocol_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 256),
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Default:  true,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMembersV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	if !config.useOctavia {
+		return fmt.Errorf("openstack_lb_members_v2 is only supported by Octavia, set use_octavia to true in the provider configuration")
+	}
+
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	members := expandLBMembersV2(d.Get("member").(*schema.Set))
+
+	// Get a clean copy of the parent pool.
+	parentPool, err := pools.Get(lbClient, poolID).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve parent pool %s: %s", poolID, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = resourceMembersV2BatchUpdate(lbClient, parentPool, members, timeout)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(poolID)
+
+	return resourceMembersV2Read(d, meta)
+}
+
+func resourceMembersV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	allPages, err := pools.ListMembers(lbClient, d.Id(), pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_lb_members_v2")
+	}
+
+	members, err := pools.ExtractMembers(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_lb_members_v2 %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved members of pool %s: %#v", d.Id(), members)
+
+	d.Set("pool_id", d.Id())
+	d.Set("region", GetRegion(d, config))
+
+	if err := d.Set("member", flattenLBMembersV2(members)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_lb_members_v2 %s member: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceMembersV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	if d.HasChange("member") {
+		members := expandLBMembersV2(d.Get("member").(*schema.Set))
+
+		// Get a clean copy of the parent pool.
+		parentPool, err := pools.Get(lbClient, d.Id()).Extract()
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve parent pool %s: %s", d.Id(), err)
+		}
+
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		err = resourceMembersV2BatchUpdate(lbClient, parentPool, members, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceMembersV2Read(d, meta)
+}
+
+func resourceMembersV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.loadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancer client: %s", err)
+	}
+
+	// Get a clean copy of the parent pool.
+	parentPool, err := pools.Get(lbClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Unable to retrieve parent pool")
+	}
+
+	// An empty batch update removes all of the members of the pool.
+	timeout := d.Timeout(schema.TimeoutDelete)
+	err = resourceMembersV2BatchUpdate(lbClient, parentPool, []octaviapools.BatchUpdateMemberOpts{}, timeout)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// resourceMembersV2BatchUpdate replaces all of the members of a pool with a
+// single request and waits for the load balancer to become active again.
+func resourceMembersV2BatchUpdate(lbClient *gophercloud.ServiceClient, parentPool *pools.Pool, members []octaviapools.BatchUpdateMemberOpts, timeout time.Duration) error {
+	poolID := parentPool.ID
+
+	// Wait for parent pool to become active before continuing.
+	err := waitForLBV2Pool(lbClient, parentPool, "ACTIVE", lbPendingStatuses, timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating the members of pool %s with: %#v", poolID, members)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = octaviapools.BatchUpdateMembers(lbClient, poolID, members).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error updating the members of pool %s: %s", poolID, err)
+	}
+
+	// Wait for parent pool to become active again.
+	err = waitForLBV2Pool(lbClient, parentPool, "ACTIVE", lbPendingStatuses, timeout)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -111,6 +111,11 @@ func resourceNetworkingFloatingIPV2() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^$|\.$`), "fully-qualified (unambiguous) DNS domain names must have a dot at the end"),
 			},
+
+			"qos_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -156,6 +161,13 @@ func resourceNetworkFloatingIPV2Create(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if qosPolicyID := d.Get("qos_policy_id").(string); qosPolicyID != "" {
+		finalCreateOpts = FloatingIPQoSCreateOptsExt{
+			CreateOptsBuilder: finalCreateOpts,
+			QoSPolicyID:       qosPolicyID,
+		}
+	}
+
 	var fip floatingIPExtended
 
 	log.Printf("[DEBUG] openstack_networking_floatingip_v2 create options: %#v", finalCreateOpts)
@@ -218,6 +230,7 @@ func resourceNetworkFloatingIPV2Read(d *schema.ResourceData, meta interface{}) e
 	d.Set("tenant_id", fip.TenantID)
 	d.Set("dns_name", fip.DNSName)
 	d.Set("dns_domain", fip.DNSDomain)
+	d.Set("qos_policy_id", fip.QoSPolicyID)
 	d.Set("region", GetRegion(d, config))
 
 	networkV2ReadAttributesTags(d, fip.Tags)
@@ -240,6 +253,7 @@ func resourceNetworkFloatingIPV2Update(d *schema.ResourceData, meta interface{})
 
 	var hasChange bool
 	var updateOpts floatingips.UpdateOpts
+	var finalUpdateOpts floatingips.UpdateOptsBuilder
 
 	if d.HasChange("description") {
 		hasChange = true
@@ -260,9 +274,20 @@ func resourceNetworkFloatingIPV2Update(d *schema.ResourceData, meta interface{})
 		updateOpts.FixedIP = fixedIP
 	}
 
+	finalUpdateOpts = updateOpts
+
+	if d.HasChange("qos_policy_id") {
+		hasChange = true
+		qosPolicyID := d.Get("qos_policy_id").(string)
+		finalUpdateOpts = FloatingIPQoSUpdateOptsExt{
+			UpdateOptsBuilder: finalUpdateOpts,
+			QoSPolicyID:       &qosPolicyID,
+		}
+	}
+
 	if hasChange {
-		log.Printf("[DEBUG] openstack_networking_floatingip_v2 %s update options: %#v", d.Id(), updateOpts)
-		_, err = floatingips.Update(networkingClient, d.Id(), updateOpts).Extract()
+		log.Printf("[DEBUG] openstack_networking_floatingip_v2 %s update options: %#v", d.Id(), finalUpdateOpts)
+		_, err = floatingips.Update(networkingClient, d.Id(), finalUpdateOpts).Extract()
 		if err != nil {
 			return fmt.Errorf("Error updating openstack_networking_floatingip_v2 %s: %s", d.Id(), err)
 		}
@@ -78,6 +78,68 @@ func TestAccNetworkingV2FloatingIP_timeout(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2FloatingIP_qos_policy_create(t *testing.T) {
+	var fip floatingips.FloatingIP
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2FloatingIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2FloatingIP_qos_policy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists("openstack_networking_floatingip_v2.fip_1", &fip),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_floatingip_v2.fip_1", "qos_policy_id",
+						"openstack_networking_qos_policy_v2.qos_policy_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2FloatingIP_qos_policy_update(t *testing.T) {
+	var fip floatingips.FloatingIP
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2FloatingIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2FloatingIP_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists("openstack_networking_floatingip_v2.fip_1", &fip),
+				),
+			},
+			{
+				Config: testAccNetworkingV2FloatingIP_qos_policy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists("openstack_networking_floatingip_v2.fip_1", &fip),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_floatingip_v2.fip_1", "qos_policy_id",
+						"openstack_networking_qos_policy_v2.qos_policy_1", "id"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2FloatingIP_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists("openstack_networking_floatingip_v2.fip_1", &fip),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_floatingip_v2.fip_1", "qos_policy_id", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2FloatingIPDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkClient, err := config.networkingV2Client(OS_REGION_NAME)
@@ -268,3 +330,14 @@ resource "openstack_networking_floatingip_v2" "fip_1" {
   }
 }
 `
+
+const testAccNetworkingV2FloatingIP_qos_policy = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  description   = "test floating IP"
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+}
+`
@@ -429,6 +429,68 @@ func TestAccNetworkingV2Network_portSecurity_enabled(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Network_qos_policy_create(t *testing.T) {
+	var network networks.Network
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2NetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2Network_qos_policy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_network_v2.network_1", "qos_policy_id",
+						"openstack_networking_qos_policy_v2.qos_policy_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Network_qos_policy_update(t *testing.T) {
+	var network networks.Network
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2NetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2Network_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+				),
+			},
+			{
+				Config: testAccNetworkingV2Network_qos_policy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_network_v2.network_1", "qos_policy_id",
+						"openstack_networking_qos_policy_v2.qos_policy_1", "id"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2Network_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "qos_policy_id", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2NetworkDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
@@ -696,3 +758,15 @@ resource "openstack_networking_network_v2" "network_1" {
   port_security_enabled = "true"
 }
 `
+
+const testAccNetworkingV2Network_qos_policy = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name           = "network_1"
+  admin_state_up = "true"
+  qos_policy_id  = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+}
+`
@@ -0,0 +1,119 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
+)
+
+func TestAccNetworkingV2QoSPolicyBasic(t *testing.T) {
+	var qosPolicy policies.Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2QoSPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2QoSPolicyBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2QoSPolicyExists(
+						"openstack_networking_qos_policy_v2.qos_policy_1", &qosPolicy),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_policy_v2.qos_policy_1", "name", "qos_policy_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_policy_v2.qos_policy_1", "description", "terraform qos policy acceptance test"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_policy_v2.qos_policy_1", "tags.#", "2"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2QoSPolicyUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_policy_v2.qos_policy_1", "name", "qos_policy_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_policy_v2.qos_policy_1", "description", "terraform qos policy acceptance test updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_policy_v2.qos_policy_1", "tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2QoSPolicyExists(n string, qosPolicy *policies.Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := policies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("QoS policy not found")
+		}
+
+		*qosPolicy = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2QoSPolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_qos_policy_v2" {
+			continue
+		}
+
+		_, err := policies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("QoS policy still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkingV2QoSPolicyBasic = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name        = "qos_policy_1"
+  description = "terraform qos policy acceptance test"
+  tags        = ["foo", "bar"]
+}
+`
+
+const testAccNetworkingV2QoSPolicyUpdate = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name        = "qos_policy_1"
+  description = "terraform qos policy acceptance test updated"
+  tags        = ["foo"]
+}
+`
@@ -0,0 +1,173 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingQuotaV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQuotaV2Create,
+		Read:   resourceNetworkingQuotaV2Read,
+		Update: resourceNetworkingQuotaV2Update,
+		Delete: resourceNetworkingQuotaV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"floatingip": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"network": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"rbac_policy": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"router": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"security_group": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"security_group_rule": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"subnet": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"subnetpool": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingQuotaV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	updateOpts := expandNetworkingQuotaV2UpdateOpts(d)
+
+	log.Printf("[DEBUG] openstack_networking_quota_v2 %s create options: %#v", projectID, updateOpts)
+
+	_, err = quotas.Update(networkingClient, projectID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_networking_quota_v2 %s: %s", projectID, err)
+	}
+
+	d.SetId(projectID)
+
+	log.Printf("[DEBUG] Created openstack_networking_quota_v2 %s", projectID)
+
+	return resourceNetworkingQuotaV2Read(d, meta)
+}
+
+func resourceNetworkingQuotaV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	q, err := quotas.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_networking_quota_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_quota_v2 %s: %#v", d.Id(), q)
+
+	for k, v := range flattenNetworkingQuotaV2(q) {
+		d.Set(k, v)
+	}
+
+	d.Set("project_id", d.Id())
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingQuotaV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	updateOpts := expandNetworkingQuotaV2UpdateOpts(d)
+
+	log.Printf("[DEBUG] openstack_networking_quota_v2 %s update options: %#v", d.Id(), updateOpts)
+
+	_, err = quotas.Update(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_networking_quota_v2 %s: %s", d.Id(), err)
+	}
+
+	return resourceNetworkingQuotaV2Read(d, meta)
+}
+
+// resourceNetworkingQuotaV2Delete resets the quotas of the project to the
+// defaults.
+func resourceNetworkingQuotaV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := networkingQuotaV2Delete(networkingClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "Error resetting openstack_networking_quota_v2")
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+)
+
+func TestAccNetworkingV2QuotaBasic(t *testing.T) {
+	var quota quotas.Quota
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2QuotaBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2QuotaExists("openstack_networking_quota_v2.quota_1", &quota),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_quota_v2.quota_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "floatingip", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "network", "4"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "port", "100"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "router", "4"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2QuotaUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2QuotaExists("openstack_networking_quota_v2.quota_1", &quota),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "floatingip", "5"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "network", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "port", "50"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_quota_v2.quota_1", "router", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2QuotaExists(n string, quota *quotas.Quota) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := quotas.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		*quota = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2QuotaBasic = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_networking_quota_v2" "quota_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  floatingip = 10
+  network    = 4
+  port       = 100
+  router     = 4
+}
+`
+
+const testAccNetworkingV2QuotaUpdate = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_networking_quota_v2" "quota_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  floatingip = 5
+  network    = 2
+  port       = 50
+  router     = 0
+}
+`
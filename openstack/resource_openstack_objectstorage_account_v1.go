@@ -0,0 +1,239 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceObjectStorageAccountV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceObjectStorageAccountV1Create,
+		Read:   resourceObjectStorageAccountV1Read,
+		Update: resourceObjectStorageAccountV1Update,
+		Delete: resourceObjectStorageAccountV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateObjectStorageAccountV1Metadata,
+			},
+
+			"temp_url_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"temp_url_key_2": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			// Computed-only
+			"bytes_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"container_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"quota_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceObjectStorageAccountV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	account, err := objectStorageAccountV1ID(objectStorageClient)
+	if err != nil {
+		return err
+	}
+
+	updateOpts := accounts.UpdateOpts{
+		Metadata:    resourceObjectStorageAccountV1Metadata(d),
+		TempURLKey:  d.Get("temp_url_key").(string),
+		TempURLKey2: d.Get("temp_url_key_2").(string),
+	}
+
+	// Don't log the temp URL keys.
+	log.Printf("[DEBUG] openstack_objectstorage_account_v1 %s metadata: %#v", account, updateOpts.Metadata)
+	_, err = accounts.Update(objectStorageClient, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_objectstorage_account_v1: %s", err)
+	}
+
+	d.SetId(account)
+
+	return resourceObjectStorageAccountV1Read(d, meta)
+}
+
+func resourceObjectStorageAccountV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	result := accounts.Get(objectStorageClient, nil)
+	account, err := result.Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_objectstorage_account_v1")
+	}
+
+	metadata, err := result.ExtractMetadata()
+	if err != nil {
+		return fmt.Errorf("Error retrieving metadata of openstack_objectstorage_account_v1 %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_objectstorage_account_v1 %s: %d containers, %d objects, %d bytes", d.Id(), account.ContainerCount, account.ObjectCount, account.BytesUsed)
+
+	d.Set("temp_url_key", account.TempURLKey)
+	d.Set("temp_url_key_2", account.TempURLKey2)
+	d.Set("bytes_used", account.BytesUsed)
+	d.Set("container_count", account.ContainerCount)
+	d.Set("object_count", account.ObjectCount)
+	d.Set("region", GetRegion(d, config))
+
+	if account.QuotaBytes != nil {
+		d.Set("quota_bytes", *account.QuotaBytes)
+	} else {
+		d.Set("quota_bytes", 0)
+	}
+
+	configured, _ := d.Get("metadata").(map[string]interface{})
+	if err := d.Set("metadata", objectStorageV1Metadata(objectStorageAccountV1Metadata(metadata), configured)); err != nil {
+		log.Printf("[DEBUG] Unable to set metadata for openstack_objectstorage_account_v1 %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceObjectStorageAccountV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	var removed []string
+	updateOpts := accounts.UpdateOpts{}
+
+	if d.HasChange("metadata") {
+		updateOpts.Metadata = resourceObjectStorageAccountV1Metadata(d)
+
+		o, _ := d.GetChange("metadata")
+		for key := range o.(map[string]interface{}) {
+			if _, ok := updateOpts.Metadata[key]; !ok {
+				removed = append(removed, key)
+			}
+		}
+	}
+
+	// Keys are rotated by setting the new key as temp_url_key_2, then
+	// moving it to temp_url_key once the signed URLs are updated.
+	if d.HasChange("temp_url_key") {
+		if v := d.Get("temp_url_key").(string); v != "" {
+			updateOpts.TempURLKey = v
+		} else {
+			removed = append(removed, objectStorageAccountV1TempURLKey)
+		}
+	}
+
+	if d.HasChange("temp_url_key_2") {
+		if v := d.Get("temp_url_key_2").(string); v != "" {
+			updateOpts.TempURLKey2 = v
+		} else {
+			removed = append(removed, objectStorageAccountV1TempURLKey2)
+		}
+	}
+
+	opts := AccountUpdateOpts{
+		UpdateOptsBuilder: updateOpts,
+		RemoveMetadata:    removed,
+	}
+
+	log.Printf("[DEBUG] openstack_objectstorage_account_v1 %s metadata: %#v, removed metadata: %#v", d.Id(), updateOpts.Metadata, removed)
+	_, err = accounts.Update(objectStorageClient, opts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_objectstorage_account_v1 %s: %s", d.Id(), err)
+	}
+
+	return resourceObjectStorageAccountV1Read(d, meta)
+}
+
+func resourceObjectStorageAccountV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	// An account cannot be deleted. Remove the metadata and the keys
+	// managed by this resource instead.
+	var removed []string
+	for key := range d.Get("metadata").(map[string]interface{}) {
+		removed = append(removed, key)
+	}
+	if d.Get("temp_url_key").(string) != "" {
+		removed = append(removed, objectStorageAccountV1TempURLKey)
+	}
+	if d.Get("temp_url_key_2").(string) != "" {
+		removed = append(removed, objectStorageAccountV1TempURLKey2)
+	}
+
+	if len(removed) == 0 {
+		return nil
+	}
+
+	opts := AccountUpdateOpts{
+		UpdateOptsBuilder: accounts.UpdateOpts{},
+		RemoveMetadata:    removed,
+	}
+
+	log.Printf("[DEBUG] openstack_objectstorage_account_v1 %s removed metadata: %#v", d.Id(), removed)
+	_, err = accounts.Update(objectStorageClient, opts).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_objectstorage_account_v1")
+	}
+
+	return nil
+}
+
+func resourceObjectStorageAccountV1Metadata(d *schema.ResourceData) map[string]string {
+	m := make(map[string]string)
+	for key, val := range d.Get("metadata").(map[string]interface{}) {
+		m[key] = val.(string)
+	}
+	return m
+}
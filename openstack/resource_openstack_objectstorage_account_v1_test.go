@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccObjectStorageV1Account_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObjectStorageV1AccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1Account_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"openstack_objectstorage_account_v1.account_1", "container_count"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.test", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "temp_url_key", "tf-test-key-1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "temp_url_key_2", ""),
+				),
+			},
+			{
+				Config: testAccObjectStorageV1Account_rotate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "temp_url_key", "tf-test-key-1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "temp_url_key_2", "tf-test-key-2"),
+				),
+			},
+			{
+				Config: testAccObjectStorageV1Account_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.foo", "bar"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "temp_url_key", "tf-test-key-2"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "temp_url_key_2", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStorageV1AccountDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_objectstorage_account_v1" {
+			continue
+		}
+
+		result := accounts.Get(objectStorageClient, nil)
+		account, err := result.Extract()
+		if err != nil {
+			return err
+		}
+
+		if account.TempURLKey != "" || account.TempURLKey2 != "" {
+			return fmt.Errorf("Account temp URL keys still exist")
+		}
+
+		metadata, err := result.ExtractMetadata()
+		if err != nil {
+			return err
+		}
+
+		if len(objectStorageAccountV1Metadata(metadata)) != 0 {
+			return fmt.Errorf("Account metadata still exists: %#v", metadata)
+		}
+	}
+
+	return nil
+}
+
+const testAccObjectStorageV1Account_basic = `
+resource "openstack_objectstorage_account_v1" "account_1" {
+  metadata = {
+    test = "true"
+  }
+
+  temp_url_key = "tf-test-key-1"
+}
+`
+
+const testAccObjectStorageV1Account_rotate = `
+resource "openstack_objectstorage_account_v1" "account_1" {
+  metadata = {
+    test = "true"
+  }
+
+  temp_url_key = "tf-test-key-1"
+  temp_url_key_2 = "tf-test-key-2"
+}
+`
+
+const testAccObjectStorageV1Account_update = `
+resource "openstack_objectstorage_account_v1" "account_1" {
+  metadata = {
+    foo = "bar"
+  }
+
+  temp_url_key = "tf-test-key-2"
+}
+`
@@ -3,6 +3,7 @@ package openstack
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
@@ -18,6 +19,9 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 		Read:   resourceObjectStorageContainerV1Read,
 		Update: resourceObjectStorageContainerV1Update,
 		Delete: resourceObjectStorageContainerV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -77,9 +81,57 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 				},
 			},
 			"metadata": {
-				Type:     schema.TypeMap,
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ForceNew:     false,
+				ValidateFunc: validateObjectStorageContainerV1Metadata,
+			},
+			"storage_policy": {
+				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: false,
+				Computed: true,
+				ForceNew: true,
+			},
+			"quota_bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"quota_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cors": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_origins": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"expose_headers": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"max_age": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
 			},
 			"force_destroy": {
 				Type:     schema.TypeBool,
@@ -99,13 +151,19 @@ func resourceObjectStorageContainerV1Create(d *schema.ResourceData, meta interfa
 
 	cn := d.Get("name").(string)
 
+	metadata := resourceContainerMetadataV2(d)
+	typedMetadata, _ := objectStorageContainerV1TypedMetadata(d)
+	for k, v := range typedMetadata {
+		metadata[k] = v
+	}
+
 	createOpts := &containers.CreateOpts{
 		ContainerRead:    d.Get("container_read").(string),
 		ContainerSyncTo:  d.Get("container_sync_to").(string),
 		ContainerSyncKey: d.Get("container_sync_key").(string),
 		ContainerWrite:   d.Get("container_write").(string),
 		ContentType:      d.Get("content_type").(string),
-		Metadata:         resourceContainerMetadataV2(d),
+		Metadata:         metadata,
 	}
 
 	versioning := d.Get("versioning").(*schema.Set)
@@ -121,8 +179,16 @@ func resourceObjectStorageContainerV1Create(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	_, err = containers.Create(objectStorageClient, cn, createOpts).Extract()
+	var opts containers.CreateOptsBuilder = createOpts
+	if v, ok := d.GetOk("storage_policy"); ok {
+		opts = ContainerCreateOpts{
+			CreateOptsBuilder: createOpts,
+			StoragePolicy:     v.(string),
+		}
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", opts)
+	_, err = containers.Create(objectStorageClient, cn, opts).Extract()
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack container: %s", err)
 	}
@@ -148,8 +214,54 @@ func resourceObjectStorageContainerV1Read(d *schema.ResourceData, meta interface
 		return CheckDeleted(d, result.Err, "container")
 	}
 
+	container, err := result.Extract()
+	if err != nil {
+		return fmt.Errorf("Error extracting headers for OpenStack container %s: %s", d.Id(), err)
+	}
+
+	metadata, err := result.ExtractMetadata()
+	if err != nil {
+		return fmt.Errorf("Error extracting metadata for OpenStack container %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack container %s: %#v", d.Id(), container)
+
+	var versioning []map[string]interface{}
+	switch {
+	case container.VersionsLocation != "":
+		versioning = append(versioning, map[string]interface{}{
+			"type":     "versions",
+			"location": container.VersionsLocation,
+		})
+	case container.HistoryLocation != "":
+		versioning = append(versioning, map[string]interface{}{
+			"type":     "history",
+			"location": container.HistoryLocation,
+		})
+	}
+
+	metadata, err = objectStorageContainerV1SetTypedMetadata(d, metadata)
+	if err != nil {
+		return fmt.Errorf("Error reading OpenStack container %s: %s", d.Id(), err)
+	}
+
+	// The Content-Type of a container is not stored by Swift,
+	// so content_type is not read back.
+	d.Set("name", d.Id())
+	d.Set("storage_policy", container.StoragePolicy)
+	d.Set("container_read", strings.Join(container.Read, ","))
+	d.Set("container_write", strings.Join(container.Write, ","))
 	d.Set("region", GetRegion(d, config))
 
+	if err := d.Set("versioning", versioning); err != nil {
+		log.Printf("[DEBUG] Unable to set versioning for OpenStack container %s: %s", d.Id(), err)
+	}
+
+	configured, _ := d.Get("metadata").(map[string]interface{})
+	if err := d.Set("metadata", objectStorageV1Metadata(metadata, configured)); err != nil {
+		log.Printf("[DEBUG] Unable to set metadata for OpenStack container %s: %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -192,6 +304,25 @@ func resourceObjectStorageContainerV1Update(d *schema.ResourceData, meta interfa
 
 	if d.HasChange("metadata") {
 		updateOpts.Metadata = resourceContainerMetadataV2(d)
+
+		o, _ := d.GetChange("metadata")
+		for key := range o.(map[string]interface{}) {
+			if _, ok := updateOpts.Metadata[key]; !ok && !objectStorageContainerV1IsReservedMetadata(key) {
+				updateOpts.RemoveMetadata = append(updateOpts.RemoveMetadata, key)
+			}
+		}
+	}
+
+	if d.HasChange("quota_bytes") || d.HasChange("quota_count") || d.HasChange("cors") {
+		if updateOpts.Metadata == nil {
+			updateOpts.Metadata = make(map[string]string)
+		}
+
+		typedMetadata, removed := objectStorageContainerV1TypedMetadata(d)
+		for k, v := range typedMetadata {
+			updateOpts.Metadata[k] = v
+		}
+		updateOpts.RemoveMetadata = append(updateOpts.RemoveMetadata, removed...)
 	}
 
 	_, err = containers.Update(objectStorageClient, d.Id(), updateOpts).Extract()
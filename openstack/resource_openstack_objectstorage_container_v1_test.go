@@ -38,6 +38,49 @@ func TestAccObjectStorageV1Container_basic(t *testing.T) {
 	})
 }
 
+func TestAccObjectStorageV1Container_quotasCORS(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObjectStorageV1ContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1Container_quotasCORS,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"openstack_objectstorage_container_v1.container_1", "storage_policy"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_bytes", "1048576"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_count", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "cors.0.allow_origins.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "cors.0.max_age", "3600"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "metadata.%", "1"),
+				),
+			},
+			{
+				Config: testAccObjectStorageV1Container_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_bytes", "0"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "quota_count", "0"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "cors.#", "0"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "metadata.%", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectStorageV1ContainerDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	objectStorageClient, err := config.objectStorageV1Client(OS_REGION_NAME)
@@ -78,3 +121,38 @@ resource "openstack_objectstorage_container_v1" "container_1" {
   content_type = "text/plain"
 }
 `
+
+const testAccObjectStorageV1Container_acls = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+  metadata = {
+    test = "true"
+  }
+  container_read = ".r:*,.rlistings"
+  container_write = "*:*"
+
+  versioning {
+    type = "versions"
+    location = "container_1_versions"
+  }
+}
+`
+
+const testAccObjectStorageV1Container_quotasCORS = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+  metadata = {
+    test = "true"
+  }
+  content_type = "application/json"
+
+  quota_bytes = 1048576
+  quota_count = 10
+
+  cors {
+    allow_origins = ["https://example.com", "https://example.org"]
+    expose_headers = ["X-Trans-Id"]
+    max_age = 3600
+  }
+}
+`
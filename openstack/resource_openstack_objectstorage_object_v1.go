@@ -7,8 +7,10 @@ import (
 	"os"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/mitchellh/go-homedir"
 )
 
@@ -18,6 +20,9 @@ func resourceObjectStorageObjectV1() *schema.Resource {
 		Read:   resourceObjectStorageObjectV1Read,
 		Update: resourceObjectStorageObjectV1Update,
 		Delete: resourceObjectStorageObjectV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceObjectStorageObjectV1Import,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -116,6 +121,26 @@ func resourceObjectStorageObjectV1() *schema.Resource {
 				ConflictsWith: []string{"content", "copy_from", "object_manifest"},
 			},
 
+			"segment_size": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(objectStorageObjectV1MinSegmentSize),
+				ConflictsWith: []string{"content", "copy_from", "object_manifest"},
+			},
+
+			"segment_container": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"segment_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      objectStorageObjectV1DefaultSegmentConcurrency,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
 			// Read Only
 			"content_length": {
 				Type:     schema.TypeInt,
@@ -132,6 +157,11 @@ func resourceObjectStorageObjectV1() *schema.Resource {
 				Computed: true,
 			},
 
+			"static_large_object": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"trans_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -156,16 +186,29 @@ func resourceObjectStorageObjectV1Create(d *schema.ResourceData, meta interface{
 	}
 
 	var isValid bool
+	var segments []objectStorageObjectV1Segment
 	if v, ok := d.GetOk("source"); ok {
 		isValid = true
 		file, size, err := resourceObjectSourceV1(v.(string))
 		if err != nil {
 			return err
 		}
+		defer file.Close()
 
 		createOpts.Content = file
 		createOpts.ContentLength = size
-		defer file.Close()
+
+		segmentSize, err := objectStorageObjectV1SegmentSize(int64(d.Get("segment_size").(int)), size)
+		if err != nil {
+			return err
+		}
+
+		if segmentSize > 0 {
+			segments, err = resourceObjectStorageObjectV1UploadSegments(objectStorageClient, d, file, size, segmentSize)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	if v, ok := d.GetOk("content"); ok {
@@ -224,9 +267,18 @@ func resourceObjectStorageObjectV1Create(d *schema.ResourceData, meta interface{
 		createOpts.ETag = v.(string)
 	}
 
+	if segments != nil {
+		if err := objectStorageObjectV1ManifestOpts(createOpts, segments); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	_, err = objects.Create(objectStorageClient, cn, name, createOpts).Extract()
 	if err != nil {
+		if cleanupErr := objectStorageObjectV1DeleteSegments(objectStorageClient, segments); cleanupErr != nil {
+			log.Printf("[WARN] %s", cleanupErr)
+		}
 		return fmt.Errorf("Error creating OpenStack container object: %s", err)
 	}
 
@@ -256,11 +308,21 @@ func resourceObjectStorageObjectV1Read(d *schema.ResourceData, meta interface{})
 	}
 
 	log.Printf("[DEBUG] Get Options: %#v", getOpts)
-	result, err := objects.Get(objectStorageClient, cn, name, getOpts).Extract()
+	getResult := objects.Get(objectStorageClient, cn, name, getOpts)
+	if getResult.Err != nil {
+		return CheckDeleted(d, getResult.Err, "container object")
+	}
+
+	result, err := getResult.Extract()
 	if err != nil {
 		return fmt.Errorf("Error getting OpenStack container object: %s", err)
 	}
 
+	metadata, err := getResult.ExtractMetadata()
+	if err != nil {
+		return fmt.Errorf("Error getting metadata of OpenStack container object: %s", err)
+	}
+
 	log.Printf("[DEBUG] Retrieved OpenStack Object Storage Object: %#v", result)
 
 	d.Set("etag", result.ETag)
@@ -278,7 +340,14 @@ func resourceObjectStorageObjectV1Read(d *schema.ResourceData, meta interface{})
 		d.Set("last_modified", result.LastModified.Format(time.RFC3339))
 	}
 	d.Set("object_manifest", result.ObjectManifest)
+	d.Set("static_large_object", result.StaticLargeObject)
 	d.Set("trans_id", result.TransID)
+	d.Set("segment_container", objectStorageObjectV1SegmentContainer(d))
+
+	configured, _ := d.Get("metadata").(map[string]interface{})
+	if err := d.Set("metadata", objectStorageV1Metadata(metadata, configured)); err != nil {
+		log.Printf("[DEBUG] Unable to set metadata for OpenStack container object %s: %s", d.Id(), err)
+	}
 
 	return nil
 }
@@ -290,6 +359,10 @@ func resourceObjectStorageObjectV1Update(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
 	}
 
+	if !objectStorageObjectV1HasChange(d) {
+		return resourceObjectStorageObjectV1Read(d, meta)
+	}
+
 	name := d.Get("name").(string)
 	cn := d.Get("container_name").(string)
 
@@ -303,16 +376,43 @@ func resourceObjectStorageObjectV1Update(d *schema.ResourceData, meta interface{
 		createOpts.Metadata = resourceObjectMetadataV1(d)
 	}
 
+	// The segments of the current Static Large Object, if any.
+	// They are replaced when the content of the object changes.
+	oldSegments, err := objectStorageObjectV1GetSegments(objectStorageClient, cn, name)
+	if err != nil {
+		return err
+	}
+
+	var segments []objectStorageObjectV1Segment
 	if d.HasChange("source") {
 		v := d.Get("source").(string)
 		file, size, err := resourceObjectSourceV1(v)
 		if err != nil {
 			return err
 		}
+		defer file.Close()
 
 		createOpts.Content = file
 		createOpts.ContentLength = size
-		defer file.Close()
+
+		segmentSize, err := objectStorageObjectV1SegmentSize(int64(d.Get("segment_size").(int)), size)
+		if err != nil {
+			return err
+		}
+
+		if segmentSize > 0 {
+			segments, err = resourceObjectStorageObjectV1UploadSegments(objectStorageClient, d, file, size, segmentSize)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	replaced := d.HasChange("source") || d.HasChange("content") || d.HasChange("copy_from") || d.HasChange("object_manifest")
+	if !replaced && oldSegments != nil {
+		// Write the current manifest again to keep the content of the object.
+		segments = oldSegments
+		oldSegments = nil
 	}
 
 	if d.HasChange("content") {
@@ -367,12 +467,28 @@ func resourceObjectStorageObjectV1Update(d *schema.ResourceData, meta interface{
 		createOpts.ETag = d.Get("etag").(string)
 	}
 
+	if segments != nil {
+		if err := objectStorageObjectV1ManifestOpts(createOpts, segments); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] Update Options: %#v", createOpts)
 	_, err = objects.Create(objectStorageClient, cn, name, createOpts).Extract()
 	if err != nil {
+		if d.HasChange("source") {
+			if cleanupErr := objectStorageObjectV1DeleteSegments(objectStorageClient, segments); cleanupErr != nil {
+				log.Printf("[WARN] %s", cleanupErr)
+			}
+		}
 		return fmt.Errorf("Error updating OpenStack container object: %s", err)
 	}
 
+	// The previous segments are not referenced by the object anymore.
+	if err := objectStorageObjectV1DeleteSegments(objectStorageClient, oldSegments); err != nil {
+		return err
+	}
+
 	return resourceObjectStorageObjectV1Read(d, meta)
 }
 
@@ -385,15 +501,37 @@ func resourceObjectStorageObjectV1Delete(d *schema.ResourceData, meta interface{
 
 	name := d.Get("name").(string)
 	cn := d.Get("container_name").(string)
-	deleteOpts := &objects.DeleteOpts{}
 
-	_, err = objects.Delete(objectStorageClient, cn, name, deleteOpts).Extract()
+	err = objectStorageObjectV1Delete(objectStorageClient, cn, name)
 	if err != nil {
 		return fmt.Errorf("Error getting OpenStack container object: %s", err)
 	}
+
 	return nil
 }
 
+func resourceObjectStorageObjectV1Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	cn, name, err := objectStorageObjectV1ParseID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("container_name", cn)
+	d.Set("name", name)
+
+	d.Set("segment_concurrency", objectStorageObjectV1DefaultSegmentConcurrency)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceObjectStorageObjectV1UploadSegments(client *gophercloud.ServiceClient, d *schema.ResourceData, file *os.File, size, segmentSize int64) ([]objectStorageObjectV1Segment, error) {
+	container := objectStorageObjectV1SegmentContainer(d)
+	prefix := objectStorageObjectV1SegmentPrefix(d.Get("name").(string), size, segmentSize)
+
+	log.Printf("[DEBUG] Uploading %d bytes in segments of %d bytes to %s/%s", size, segmentSize, container, prefix)
+	return objectStorageObjectV1UploadSegments(client, container, prefix, file, size, segmentSize, d.Get("segment_concurrency").(int))
+}
+
 func resourceObjectMetadataV1(d *schema.ResourceData) map[string]string {
 	m := make(map[string]string)
 	for key, val := range d.Get("metadata").(map[string]interface{}) {
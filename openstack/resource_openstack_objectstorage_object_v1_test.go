@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -203,6 +204,93 @@ func TestAccObjectStorageV1Object_objectManifest(t *testing.T) {
 	})
 }
 
+func TestAccObjectStorageV1Object_segmented(t *testing.T) {
+	var object objects.GetHeader
+
+	tmpfile1 := testAccObjectStorageV1ObjectTempFile(t, 3*1024*1024+512)
+	defer os.Remove(tmpfile1)
+	tmpfile2 := testAccObjectStorageV1ObjectTempFile(t, 2*1024*1024+512)
+	defer os.Remove(tmpfile2)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckSwift(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			if err := testAccCheckObjectStorageV1ObjectDestroy(s, "terraform/test/backup.img"); err != nil {
+				return err
+			}
+			return testAccCheckObjectStorageV1ObjectSegmentsDestroy("tf_test_container_1_segments", 0)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccObjectStorageV1Object_segmented, tmpfile1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageV1ObjectExists(
+						"openstack_objectstorage_object_v1.myfile", &object),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "static_large_object", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "segment_container", "tf_test_container_1_segments"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "content_length", fmt.Sprintf("%d", 3*1024*1024+512)),
+					testAccCheckObjectStorageV1ObjectSegments("tf_test_container_1_segments", 4),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccObjectStorageV1Object_segmented, tmpfile2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageV1ObjectExists(
+						"openstack_objectstorage_object_v1.myfile", &object),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "static_large_object", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "content_length", fmt.Sprintf("%d", 2*1024*1024+512)),
+					testAccCheckObjectStorageV1ObjectSegments("tf_test_container_1_segments", 3),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectStorageV1Object_segmentAttributes(t *testing.T) {
+	var object objects.GetHeader
+
+	tmpfile := testAccObjectStorageV1ObjectTempFile(t, 1024)
+	defer os.Remove(tmpfile)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckSwift(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckObjectStorageV1ObjectDestroy(s, "terraform/test/small.img")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccObjectStorageV1Object_segmentAttributes, tmpfile, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageV1ObjectExists(
+						"openstack_objectstorage_object_v1.myfile", &object),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "static_large_object", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "content_length", "1024"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccObjectStorageV1Object_segmentAttributes, tmpfile, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageV1ObjectExists(
+						"openstack_objectstorage_object_v1.myfile", &object),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "segment_concurrency", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "content_length", "1024"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectStorageV1ObjectDestroy(s *terraform.State, objectname string) error {
 	config := testAccProvider.Meta().(*Config)
 	objectStorageClient, err := config.objectStorageV1Client(OS_REGION_NAME)
@@ -258,6 +346,56 @@ func testAccCheckObjectStorageV1ObjectExists(n string, object *objects.GetHeader
 	}
 }
 
+func testAccCheckObjectStorageV1ObjectSegments(container string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return testAccCheckObjectStorageV1ObjectSegmentsDestroy(container, expected)
+	}
+}
+
+func testAccCheckObjectStorageV1ObjectSegmentsDestroy(container string, expected int) error {
+	config := testAccProvider.Meta().(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	allPages, err := objects.List(objectStorageClient, container, &objects.ListOpts{Full: true}).AllPages()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok && expected == 0 {
+			return nil
+		}
+		return err
+	}
+
+	names, err := objects.ExtractNames(allPages)
+	if err != nil {
+		return err
+	}
+
+	if len(names) != expected {
+		return fmt.Errorf("Expected %d segments in %s, got %d", expected, container, len(names))
+	}
+
+	return nil
+}
+
+func testAccObjectStorageV1ObjectTempFile(t *testing.T, size int) string {
+	tmpfile, err := ioutil.TempFile("", "tf_test_objectstorage_object")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tmpfile.Write([]byte(strings.Repeat("a", size))); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tmpfile.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return tmpfile.Name()
+}
+
 func testAccCheckObjectStorageV1ObjectDeleteAtMatches(expected string, object *objects.GetHeader) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		expectedTime, err := time.Parse(time.RFC3339, expected)
@@ -413,3 +551,35 @@ resource "openstack_objectstorage_object_v1" "myfile" {
   }
 }
 `
+
+const testAccObjectStorageV1Object_segmented = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+}
+
+resource "openstack_objectstorage_container_v1" "container_1_segments" {
+  name = "tf_test_container_1_segments"
+  force_destroy = true
+}
+
+resource "openstack_objectstorage_object_v1" "myfile" {
+  name = "terraform/test/backup.img"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  segment_container = "${openstack_objectstorage_container_v1.container_1_segments.name}"
+  segment_size = 1048576
+  source = "%s"
+}
+`
+
+const testAccObjectStorageV1Object_segmentAttributes = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+}
+
+resource "openstack_objectstorage_object_v1" "myfile" {
+  name = "terraform/test/small.img"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  source = "%s"
+  segment_concurrency = %d
+}
+`
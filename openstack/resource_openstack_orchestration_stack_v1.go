@@ -0,0 +1,382 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacktemplates"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceOrchestrationStackV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOrchestrationStackV1Create,
+		Read:   resourceOrchestrationStackV1Read,
+		Update: resourceOrchestrationStackV1Update,
+		Delete: resourceOrchestrationStackV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOrchestrationStackV1Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"template": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"template_url"},
+				ValidateFunc:     validateOrchestrationStackV1Template,
+				DiffSuppressFunc: orchestrationStackV1TemplateDiffSuppressFunc,
+			},
+
+			"template_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template"},
+			},
+
+			"environment": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateOrchestrationStackV1Environment,
+				DiffSuppressFunc: orchestrationStackV1TemplateDiffSuppressFunc,
+			},
+
+			"files": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"disable_rollback": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOrchestrationStackV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	template := d.Get("template").(string)
+	templateURL := d.Get("template_url").(string)
+	if template == "" && templateURL == "" {
+		return fmt.Errorf("One of template or template_url must be set for openstack_orchestration_stack_v1")
+	}
+
+	disableRollback := d.Get("disable_rollback").(bool)
+	createOpts := StackCreateOpts{
+		Name:            d.Get("name").(string),
+		Template:        template,
+		TemplateURL:     templateURL,
+		Environment:     d.Get("environment").(string),
+		Files:           expandToMapStringString(d.Get("files").(map[string]interface{})),
+		DisableRollback: &disableRollback,
+		Parameters:      d.Get("parameters").(map[string]interface{}),
+		Timeout:         d.Get("timeout").(int),
+		Tags:            strings.Join(expandToStringSlice(d.Get("tags").(*schema.Set).List()), ","),
+	}
+
+	log.Printf("[DEBUG] openstack_orchestration_stack_v1 create options: %#v", createOpts)
+
+	stack, err := stacks.Create(orchestrationClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_orchestration_stack_v1: %s", err)
+	}
+
+	d.SetId(stack.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATE_IN_PROGRESS", "ROLLBACK_IN_PROGRESS"},
+		Target:     []string{"CREATE_COMPLETE"},
+		Refresh:    orchestrationStackV1StateRefreshFunc(orchestrationClient, createOpts.Name, stack.ID, "CREATE"),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      0,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_orchestration_stack_v1 %s to become ready: %s", stack.ID, err)
+	}
+
+	log.Printf("[DEBUG] Created openstack_orchestration_stack_v1 %s", stack.ID)
+
+	return resourceOrchestrationStackV1Read(d, meta)
+}
+
+func resourceOrchestrationStackV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	stack, err := stacks.Find(orchestrationClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_orchestration_stack_v1")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_orchestration_stack_v1 %s: %#v", d.Id(), stack)
+
+	// Heat keeps deleted stacks around for a while.
+	if stack.Status == "DELETE_COMPLETE" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", stack.Name)
+	d.Set("disable_rollback", stack.DisableRollback)
+	d.Set("timeout", stack.Timeout)
+	d.Set("tags", stack.Tags)
+	d.Set("description", stack.Description)
+	d.Set("status", stack.Status)
+	d.Set("status_reason", stack.StatusReason)
+	d.Set("outputs", flattenOrchestrationStackV1Outputs(stack.Outputs))
+	d.Set("created_at", stack.CreationTime.Format(time.RFC3339))
+	d.Set("updated_at", stack.UpdatedTime.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	// Heat returns the value of every parameter of the template, including
+	// the defaults. Only track the ones which were set explicitly.
+	parameters := make(map[string]string)
+	for k, v := range flattenOrchestrationStackV1Parameters(stack.Parameters) {
+		if _, ok := d.Get("parameters").(map[string]interface{})[k]; ok {
+			parameters[k] = v
+		}
+	}
+	d.Set("parameters", parameters)
+
+	// The template and the environment are only replaced by the ones of Heat
+	// when they differ, so that the format of the configuration is kept.
+	if d.Get("template_url").(string) == "" {
+		template, err := stacktemplates.Get(orchestrationClient, stack.Name, stack.ID).Extract()
+		if err != nil {
+			return fmt.Errorf("Error retrieving template of openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+		}
+
+		if !orchestrationStackV1TemplateDiffSuppressFunc("template", d.Get("template").(string), string(template), d) {
+			d.Set("template", string(template))
+		}
+	}
+
+	// The environment and the files of a stack can be retrieved starting with
+	// the Pike release of Heat.
+	environment, err := orchestrationStackV1GetEnvironment(orchestrationClient, stack.Name, stack.ID)
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return fmt.Errorf("Error retrieving environment of openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+		}
+		log.Printf("[DEBUG] Unable to retrieve environment of openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+	} else if !orchestrationStackV1EnvironmentContains(environment, d.Get("environment").(string)) {
+		b, err := json.MarshalIndent(environment, "", "  ")
+		if err != nil {
+			return fmt.Errorf("Error encoding environment of openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+		}
+		d.Set("environment", string(b))
+	}
+
+	stackFiles, err := orchestrationStackV1GetFiles(orchestrationClient, stack.Name, stack.ID)
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return fmt.Errorf("Error retrieving files of openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+		}
+		log.Printf("[DEBUG] Unable to retrieve files of openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+	} else {
+		// Heat also returns the files which it retrieved itself, e.g. for a
+		// template URL, so only the configured files are tracked.
+		files := make(map[string]string)
+		for k, v := range stackFiles {
+			if _, ok := d.Get("files").(map[string]interface{})[k]; ok {
+				files[k] = v
+			}
+		}
+		d.Set("files", files)
+	}
+
+	return nil
+}
+
+func resourceOrchestrationStackV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	// A stack update replaces the whole stack definition, so all of the
+	// arguments are sent, not only the changed ones.
+	disableRollback := d.Get("disable_rollback").(bool)
+	updateOpts := StackUpdateOpts{
+		Template:        d.Get("template").(string),
+		TemplateURL:     d.Get("template_url").(string),
+		Environment:     d.Get("environment").(string),
+		Files:           expandToMapStringString(d.Get("files").(map[string]interface{})),
+		DisableRollback: &disableRollback,
+		Parameters:      d.Get("parameters").(map[string]interface{}),
+		Timeout:         d.Get("timeout").(int),
+		Tags:            strings.Join(expandToStringSlice(d.Get("tags").(*schema.Set).List()), ","),
+	}
+
+	log.Printf("[DEBUG] openstack_orchestration_stack_v1 %s update options: %#v", d.Id(), updateOpts)
+
+	stackName := d.Get("name").(string)
+	err = stacks.Update(orchestrationClient, stackName, d.Id(), updateOpts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"UPDATE_IN_PROGRESS", "ROLLBACK_IN_PROGRESS"},
+		Target:     []string{"UPDATE_COMPLETE"},
+		Refresh:    orchestrationStackV1StateRefreshFunc(orchestrationClient, stackName, d.Id(), "UPDATE"),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      0,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_orchestration_stack_v1 %s to update: %s", d.Id(), err)
+	}
+
+	return resourceOrchestrationStackV1Read(d, meta)
+}
+
+func resourceOrchestrationStackV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	stackName := d.Get("name").(string)
+	err = stacks.Delete(orchestrationClient, stackName, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting openstack_orchestration_stack_v1")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"DELETE_IN_PROGRESS"},
+		Target:     []string{"DELETE_COMPLETE"},
+		Refresh:    orchestrationStackV1StateRefreshFunc(orchestrationClient, stackName, d.Id(), "DELETE"),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_orchestration_stack_v1 %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceOrchestrationStackV1Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	stack, err := stacks.Find(orchestrationClient, d.Id()).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+	}
+
+	template, err := stacktemplates.Get(orchestrationClient, stack.Name, stack.ID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving template of openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+	}
+
+	// Heat returns the value of every parameter of the template. Only import
+	// the ones which differ from their default value, since there is no way
+	// to tell which of them were set explicitly.
+	parameters, err := orchestrationStackV1NonDefaultParameters(string(template), flattenOrchestrationStackV1Parameters(stack.Parameters))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing template of openstack_orchestration_stack_v1 %s: %s", d.Id(), err)
+	}
+
+	d.SetId(stack.ID)
+	d.Set("template", string(template))
+	d.Set("parameters", parameters)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,215 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
+)
+
+func TestAccOrchestrationV1Stack_basic(t *testing.T) {
+	var stack stacks.RetrievedStack
+	stackName := fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOrchestration(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOrchestrationV1StackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrchestrationV1Stack_basic(stackName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackExists("openstack_orchestration_stack_v1.stack_1", &stack),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_stack_v1.stack_1", "name", stackName),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_stack_v1.stack_1", "status", "CREATE_COMPLETE"),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_stack_v1.stack_1", "parameters.length", "4"),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_stack_v1.stack_1", "tags.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_orchestration_stack_v1.stack_1", "outputs.value"),
+				),
+			},
+			{
+				Config: testAccOrchestrationV1Stack_update(stackName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_stack_v1.stack_1", "status", "UPDATE_COMPLETE"),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_stack_v1.stack_1", "parameters.length", "8"),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_stack_v1.stack_1", "tags.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_stack_v1.stack_1", "timeout", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrchestrationV1Stack_files(t *testing.T) {
+	var stack stacks.RetrievedStack
+	stackName := fmt.Sprintf("ACPTTEST%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOrchestration(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOrchestrationV1StackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrchestrationV1Stack_files(stackName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationV1StackExists("openstack_orchestration_stack_v1.stack_1", &stack),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_stack_v1.stack_1", "status", "CREATE_COMPLETE"),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_stack_v1.stack_1", "outputs.value", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrchestrationV1StackDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_orchestration_stack_v1" {
+			continue
+		}
+
+		stack, err := stacks.Find(orchestrationClient, rs.Primary.ID).Extract()
+		if err == nil && stack.Status != "DELETE_COMPLETE" {
+			return fmt.Errorf("Stack still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckOrchestrationV1StackExists(n string, stack *stacks.RetrievedStack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		orchestrationClient, err := config.orchestrationV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+		}
+
+		found, err := stacks.Find(orchestrationClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Stack not found")
+		}
+
+		*stack = *found
+
+		return nil
+	}
+}
+
+const testAccOrchestrationV1Stack_template = `
+heat_template_version: 2016-10-14
+parameters:
+  length:
+    type: number
+    default: 4
+resources:
+  random:
+    type: OS::Heat::RandomString
+    properties:
+      length: { get_param: length }
+outputs:
+  value:
+    value: { get_attr: [random, value] }
+`
+
+func testAccOrchestrationV1Stack_basic(stackName string) string {
+	return fmt.Sprintf(`
+resource "openstack_orchestration_stack_v1" "stack_1" {
+  name = "%s"
+  template = <<EOT
+%s
+EOT
+
+  parameters = {
+    length = 4
+  }
+
+  tags = ["foo"]
+}
+`, stackName, testAccOrchestrationV1Stack_template)
+}
+
+func testAccOrchestrationV1Stack_update(stackName string) string {
+	return fmt.Sprintf(`
+resource "openstack_orchestration_stack_v1" "stack_1" {
+  name = "%s"
+  template = <<EOT
+%s
+EOT
+
+  parameters = {
+    length = 8
+  }
+
+  tags = ["foo", "bar"]
+  timeout = 20
+}
+`, stackName, testAccOrchestrationV1Stack_template)
+}
+
+func testAccOrchestrationV1Stack_files(stackName string) string {
+	return fmt.Sprintf(`
+resource "openstack_orchestration_stack_v1" "stack_1" {
+  name = "%s"
+  template = <<EOT
+heat_template_version: 2016-10-14
+resources:
+  value:
+    type: value.yaml
+outputs:
+  value:
+    value: { get_attr: [value, value] }
+EOT
+
+  environment = <<EOT
+parameter_defaults:
+  value: foo
+EOT
+
+  files = {
+    "value.yaml" = <<EOT
+heat_template_version: 2016-10-14
+parameters:
+  value:
+    type: string
+outputs:
+  value:
+    value: { get_param: value }
+EOT
+  }
+}
+`, stackName)
+}
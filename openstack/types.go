@@ -10,8 +10,14 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/endpointgroups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/ikepolicies"
@@ -21,6 +27,9 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/orchestration/v1/stacks"
 )
 
 // LogRoundTripper satisfies the http.RoundTripper interface and is used to
@@ -194,6 +203,175 @@ func (opts FloatingIPCreateOpts) ToFloatingIPCreateMap() (map[string]interface{}
 	return BuildRequest(opts, "floatingip")
 }
 
+// FloatingIPQoSCreateOptsExt adds a QoS policy to the base floatingips.CreateOpts.
+type FloatingIPQoSCreateOptsExt struct {
+	floatingips.CreateOptsBuilder
+	QoSPolicyID string `json:"qos_policy_id,omitempty"`
+}
+
+// ToFloatingIPCreateMap casts a FloatingIPQoSCreateOptsExt struct to a map.
+func (opts FloatingIPQoSCreateOptsExt) ToFloatingIPCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToFloatingIPCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	floatingip := base["floatingip"].(map[string]interface{})
+
+	if opts.QoSPolicyID != "" {
+		floatingip["qos_policy_id"] = opts.QoSPolicyID
+	}
+
+	return base, nil
+}
+
+// FloatingIPQoSUpdateOptsExt adds a QoS policy to the base floatingips.UpdateOpts.
+// Setting QoSPolicyID to an empty string removes the QoS policy from the floating ip.
+type FloatingIPQoSUpdateOptsExt struct {
+	floatingips.UpdateOptsBuilder
+	QoSPolicyID *string `json:"qos_policy_id,omitempty"`
+}
+
+// ToFloatingIPUpdateMap casts a FloatingIPQoSUpdateOptsExt struct to a map.
+func (opts FloatingIPQoSUpdateOptsExt) ToFloatingIPUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToFloatingIPUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	floatingip := base["floatingip"].(map[string]interface{})
+
+	if opts.QoSPolicyID != nil {
+		if *opts.QoSPolicyID != "" {
+			floatingip["qos_policy_id"] = *opts.QoSPolicyID
+		} else {
+			floatingip["qos_policy_id"] = nil
+		}
+	}
+
+	return base, nil
+}
+
+// LoadBalancerV2CreateOpts represents the attributes used when creating a new
+// load balancer. Unlike loadbalancers.CreateOpts, the VIP subnet is optional,
+// since Octavia also accepts a VIP network, and the attributes which are only
+// supported by Octavia are available.
+type LoadBalancerV2CreateOpts struct {
+	Name             string `json:"name,omitempty"`
+	Description      string `json:"description,omitempty"`
+	VipSubnetID      string `json:"vip_subnet_id,omitempty"`
+	VipNetworkID     string `json:"vip_network_id,omitempty"`
+	VipQosPolicyID   string `json:"vip_qos_policy_id,omitempty"`
+	TenantID         string `json:"tenant_id,omitempty"`
+	VipAddress       string `json:"vip_address,omitempty"`
+	AdminStateUp     *bool  `json:"admin_state_up,omitempty"`
+	FlavorID         string `json:"flavor_id,omitempty"`
+	Provider         string `json:"provider,omitempty"`
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+// ToLoadBalancerCreateMap casts a LoadBalancerV2CreateOpts struct to a map.
+// It overrides loadbalancers.ToLoadBalancerCreateMap.
+func (opts LoadBalancerV2CreateOpts) ToLoadBalancerCreateMap() (map[string]interface{}, error) {
+	if opts.VipSubnetID == "" && opts.VipNetworkID == "" {
+		return nil, fmt.Errorf("One of VipSubnetID or VipNetworkID must be set")
+	}
+
+	return gophercloud.BuildRequestBody(opts, "loadbalancer")
+}
+
+// LoadBalancerV2QoSUpdateOptsExt adds a VIP QoS policy to the base
+// loadbalancers.UpdateOpts. Setting VipQosPolicyID to an empty string removes
+// the QoS policy from the VIP.
+type LoadBalancerV2QoSUpdateOptsExt struct {
+	loadbalancers.UpdateOptsBuilder
+	VipQosPolicyID *string `json:"vip_qos_policy_id,omitempty"`
+}
+
+// ToLoadBalancerUpdateMap casts a LoadBalancerV2QoSUpdateOptsExt struct to a map.
+func (opts LoadBalancerV2QoSUpdateOptsExt) ToLoadBalancerUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToLoadBalancerUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	loadbalancer := base["loadbalancer"].(map[string]interface{})
+
+	if opts.VipQosPolicyID != nil {
+		if *opts.VipQosPolicyID != "" {
+			loadbalancer["vip_qos_policy_id"] = *opts.VipQosPolicyID
+		} else {
+			loadbalancer["vip_qos_policy_id"] = nil
+		}
+	}
+
+	return base, nil
+}
+
+// ListenerV2OctaviaCreateOptsExt adds the attributes, which are only supported
+// by Octavia, to the base listeners.CreateOpts.
+type ListenerV2OctaviaCreateOptsExt struct {
+	listeners.CreateOptsBuilder `json:"-"`
+	TimeoutClientData           *int              `json:"timeout_client_data,omitempty"`
+	TimeoutMemberConnect        *int              `json:"timeout_member_connect,omitempty"`
+	TimeoutMemberData           *int              `json:"timeout_member_data,omitempty"`
+	TimeoutTCPInspect           *int              `json:"timeout_tcp_inspect,omitempty"`
+	InsertHeaders               map[string]string `json:"insert_headers,omitempty"`
+	AllowedCIDRs                []string          `json:"allowed_cidrs,omitempty"`
+}
+
+// ToListenerCreateMap casts a ListenerV2OctaviaCreateOptsExt struct to a map.
+func (opts ListenerV2OctaviaCreateOptsExt) ToListenerCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToListenerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	ext, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	listener := base["listener"].(map[string]interface{})
+	for k, v := range ext {
+		listener[k] = v
+	}
+
+	return base, nil
+}
+
+// ListenerV2OctaviaUpdateOptsExt adds the attributes, which are only supported
+// by Octavia, to the base listeners.UpdateOpts.
+type ListenerV2OctaviaUpdateOptsExt struct {
+	listeners.UpdateOptsBuilder `json:"-"`
+	TimeoutClientData           *int               `json:"timeout_client_data,omitempty"`
+	TimeoutMemberConnect        *int               `json:"timeout_member_connect,omitempty"`
+	TimeoutMemberData           *int               `json:"timeout_member_data,omitempty"`
+	TimeoutTCPInspect           *int               `json:"timeout_tcp_inspect,omitempty"`
+	InsertHeaders               *map[string]string `json:"insert_headers,omitempty"`
+	AllowedCIDRs                *[]string          `json:"allowed_cidrs,omitempty"`
+}
+
+// ToListenerUpdateMap casts a ListenerV2OctaviaUpdateOptsExt struct to a map.
+func (opts ListenerV2OctaviaUpdateOptsExt) ToListenerUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToListenerUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	ext, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	listener := base["listener"].(map[string]interface{})
+	for k, v := range ext {
+		listener[k] = v
+	}
+
+	return base, nil
+}
+
 // NetworkCreateOpts represents the attributes used when creating a new network.
 type NetworkCreateOpts struct {
 	networks.CreateOpts
@@ -230,6 +408,18 @@ func (opts PortCreateOpts) ToPortCreateMap() (map[string]interface{}, error) {
 	return BuildRequest(opts, "port")
 }
 
+// QoSPolicyCreateOpts represents the attributes used when creating a new QoS policy.
+type QoSPolicyCreateOpts struct {
+	policies.CreateOpts
+	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToPolicyCreateMap casts a CreateOpts struct to a map.
+// It overrides policies.ToPolicyCreateMap to add the ValueSpecs field.
+func (opts QoSPolicyCreateOpts) ToPolicyCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "policy")
+}
+
 // RouterCreateOpts represents the attributes used when creating a new router.
 type RouterCreateOpts struct {
 	routers.CreateOpts
@@ -292,3 +482,152 @@ type SiteConnectionCreateOpts struct {
 	siteconnections.CreateOpts
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
+
+// StackCreateOpts represents the attributes used when creating a new stack.
+// Unlike stacks.CreateOpts, the template, environment and files are sent to
+// Heat as they are, without fetching any files referenced by the template.
+type StackCreateOpts struct {
+	Name            string                 `json:"stack_name" required:"true"`
+	Template        string                 `json:"template,omitempty"`
+	TemplateURL     string                 `json:"template_url,omitempty"`
+	Environment     string                 `json:"environment,omitempty"`
+	Files           map[string]string      `json:"files,omitempty"`
+	DisableRollback *bool                  `json:"disable_rollback,omitempty"`
+	Parameters      map[string]interface{} `json:"parameters,omitempty"`
+	Timeout         int                    `json:"timeout_mins,omitempty"`
+	Tags            string                 `json:"tags,omitempty"`
+}
+
+// ToStackCreateMap casts a StackCreateOpts struct to a map.
+// It overrides stacks.ToStackCreateMap.
+func (opts StackCreateOpts) ToStackCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// StackUpdateOpts represents the attributes used when updating an existing
+// stack.
+type StackUpdateOpts struct {
+	Template        string                 `json:"template,omitempty"`
+	TemplateURL     string                 `json:"template_url,omitempty"`
+	Environment     string                 `json:"environment,omitempty"`
+	Files           map[string]string      `json:"files,omitempty"`
+	DisableRollback *bool                  `json:"disable_rollback,omitempty"`
+	Parameters      map[string]interface{} `json:"parameters,omitempty"`
+	Timeout         int                    `json:"timeout_mins,omitempty"`
+	Tags            string                 `json:"tags,omitempty"`
+}
+
+// ToStackUpdateMap casts a StackUpdateOpts struct to a map.
+// It overrides stacks.ToStackUpdateMap.
+func (opts StackUpdateOpts) ToStackUpdateMap() (map[string]interface{}, error) {
+	if opts.Template == "" && opts.TemplateURL == "" {
+		return nil, stacks.ErrTemplateRequired{}
+	}
+
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// ImageImportCreateOpts represents the attributes used when importing the
+// data of an image. Unlike imageimport.CreateOpts, the uri is omitted when
+// it is empty, which is the case for the glance-direct method.
+type ImageImportCreateOpts struct {
+	Name imageimport.ImportMethod `json:"name" required:"true"`
+	URI  string                   `json:"uri,omitempty"`
+}
+
+// ToImportCreateMap casts an ImageImportCreateOpts struct to a map.
+// It overrides imageimport.ToImportCreateMap.
+func (opts ImageImportCreateOpts) ToImportCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{"method": b}, nil
+}
+
+// ContainerCreateOpts adds the storage policy, which can only be set
+// when a container is created, to the base containers.CreateOpts.
+type ContainerCreateOpts struct {
+	containers.CreateOptsBuilder
+	StoragePolicy string `h:"X-Storage-Policy"`
+}
+
+// ToContainerCreateMap casts a ContainerCreateOpts struct to a map of headers.
+// It overrides containers.ToContainerCreateMap to add the storage policy.
+func (opts ContainerCreateOpts) ToContainerCreateMap() (map[string]string, error) {
+	base, err := opts.CreateOptsBuilder.ToContainerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	ext, err := gophercloud.BuildHeaders(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range ext {
+		base[k] = v
+	}
+
+	return base, nil
+}
+
+// AccountUpdateOpts adds the removal of metadata, which is not supported by
+// the base accounts.UpdateOpts.
+type AccountUpdateOpts struct {
+	accounts.UpdateOptsBuilder
+	RemoveMetadata []string
+}
+
+// ToAccountUpdateMap casts an AccountUpdateOpts struct to a map of headers.
+// It overrides accounts.ToAccountUpdateMap to add the removed metadata.
+func (opts AccountUpdateOpts) ToAccountUpdateMap() (map[string]string, error) {
+	base, err := opts.UpdateOptsBuilder.ToAccountUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range opts.RemoveMetadata {
+		base["X-Remove-Account-Meta-"+k] = "remove"
+	}
+
+	return base, nil
+}
+
+// VolumeV3BackupCreateOptsExt adds the backup to restore, which is not
+// supported by the base volumes.CreateOpts, to a volume creation.
+// Creating a volume from a backup requires microversion 3.47 or later.
+type VolumeV3BackupCreateOptsExt struct {
+	volumes.CreateOptsBuilder
+	BackupID string `json:"backup_id,omitempty"`
+}
+
+// ToVolumeCreateMap casts a VolumeV3BackupCreateOptsExt struct to a map.
+func (opts VolumeV3BackupCreateOptsExt) ToVolumeCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToVolumeCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	volume := base["volume"].(map[string]interface{})
+
+	if opts.BackupID != "" {
+		volume["backup_id"] = opts.BackupID
+	}
+
+	return base, nil
+}
+
+// SnapshotV3UpdateMetadataOpts represents the metadata used when updating an
+// existing snapshot. Unlike snapshots.UpdateMetadataOpts, the metadata is
+// sent even when it is empty, so that all the metadata can be removed.
+type SnapshotV3UpdateMetadataOpts struct {
+	Metadata map[string]string `json:"metadata"`
+}
+
+// ToSnapshotUpdateMetadataMap casts a SnapshotV3UpdateMetadataOpts struct to a map.
+// It overrides snapshots.ToSnapshotUpdateMetadataMap.
+func (opts SnapshotV3UpdateMetadataOpts) ToSnapshotUpdateMetadataMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
@@ -334,3 +334,22 @@ func compatibleMicroversion(direction, required, given string) (bool, error) {
 
 	return false, nil
 }
+
+// expandQuotaValue returns the value of a quota, if it was set on creation
+// or changed on update. Quotas which weren't touched are left as they are.
+func expandQuotaValue(d *schema.ResourceData, key string) *int {
+	if d.IsNewResource() {
+		if v, ok := d.GetOkExists(key); ok {
+			value := v.(int)
+			return &value
+		}
+		return nil
+	}
+
+	if d.HasChange(key) {
+		value := d.Get(key).(int)
+		return &value
+	}
+
+	return nil
+}